@@ -45,6 +45,14 @@ var (
 	TaskTypeRandom = models.MustNewTaskType("random")
 	// TaskTypeCompare is the identifier for the Compare adapter.
 	TaskTypeCompare = models.MustNewTaskType("compare")
+	// TaskTypeMedian is the identifier for the Median adapter.
+	TaskTypeMedian = models.MustNewTaskType("median")
+	// TaskTypeMean is the identifier for the Mean adapter.
+	TaskTypeMean = models.MustNewTaskType("mean")
+	// TaskTypeMode is the identifier for the Mode adapter.
+	TaskTypeMode = models.MustNewTaskType("mode")
+	// TaskTypeTrimmedMean is the identifier for the TrimmedMean adapter.
+	TaskTypeTrimmedMean = models.MustNewTaskType("trimmedmean")
 )
 
 // BaseAdapter is the minimum interface required to create an adapter. Only core
@@ -129,6 +137,18 @@ func For(task models.TaskSpec, config orm.ConfigReader, orm *orm.ORM) (*Pipeline
 	case TaskTypeCompare:
 		ba = &Compare{}
 		err = unmarshalParams(task.Params, ba)
+	case TaskTypeMedian:
+		ba = &Median{}
+		err = unmarshalParams(task.Params, ba)
+	case TaskTypeMean:
+		ba = &Mean{}
+		err = unmarshalParams(task.Params, ba)
+	case TaskTypeMode:
+		ba = &Mode{}
+		err = unmarshalParams(task.Params, ba)
+	case TaskTypeTrimmedMean:
+		ba = &TrimmedMean{}
+		err = unmarshalParams(task.Params, ba)
 	default:
 		bt, err := orm.FindBridge(task.Type)
 		if err != nil {
@@ -0,0 +1,188 @@
+package adapters
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"chainlink/core/store"
+	"chainlink/core/store/models"
+
+	"github.com/shopspring/decimal"
+	"github.com/tidwall/gjson"
+)
+
+// Aggregation holds the parameters shared by the adapters that combine an
+// array of values into one.
+//
+// The values are read from the input's "results" field, which holds the
+// results of a task's inputs, or else from its "result" field. Path reads them
+// from another field instead. Values that are not numbers are ignored, and the
+// aggregation errors if fewer than MinimumInputs valid values remain.
+//
+// If MaxDeviation is set, values deviating from the median of all valid values
+// by more than that fraction of the median are rejected as outliers before
+// aggregating.
+type Aggregation struct {
+	Path          JSONPath         `json:"path"`
+	MinimumInputs uint32           `json:"minimumInputs"`
+	MaxDeviation  *decimal.Decimal `json:"maxDeviation"`
+}
+
+// Median adapter type returns the median of an array of values.
+type Median struct {
+	Aggregation
+}
+
+// Perform returns the median of the input values. For an even number of
+// values, the mean of the two middle values is returned.
+func (m *Median) Perform(input models.RunInput, _ *store.Store) models.RunOutput {
+	return m.perform(input, median)
+}
+
+// Mean adapter type returns the mean of an array of values.
+type Mean struct {
+	Aggregation
+}
+
+// Perform returns the mean of the input values.
+func (m *Mean) Perform(input models.RunInput, _ *store.Store) models.RunOutput {
+	return m.perform(input, mean)
+}
+
+// Mode adapter type returns the most common value of an array of values.
+type Mode struct {
+	Aggregation
+}
+
+// Perform returns the most common of the input values, or the lowest of the
+// most common values if there are several.
+func (m *Mode) Perform(input models.RunInput, _ *store.Store) models.RunOutput {
+	return m.perform(input, mode)
+}
+
+// TrimmedMean adapter type returns the mean of an array of values after
+// discarding the highest and lowest values.
+type TrimmedMean struct {
+	Aggregation
+	Trim *decimal.Decimal `json:"trim"`
+}
+
+// defaultTrim is the fraction of values discarded at each end by TrimmedMean
+// when no trim is given.
+var defaultTrim = decimal.RequireFromString("0.1")
+
+// Perform discards the Trim fraction of the input values from each end,
+// rounding down, and returns the mean of the values that remain.
+func (tm *TrimmedMean) Perform(input models.RunInput, _ *store.Store) models.RunOutput {
+	trim := defaultTrim
+	if tm.Trim != nil {
+		trim = *tm.Trim
+	}
+	if trim.IsNegative() || trim.GreaterThanOrEqual(decimal.NewFromFloat(0.5)) {
+		return models.NewRunOutputError(errors.New("trim must be at least 0 and less than 0.5"))
+	}
+
+	return tm.perform(input, func(values []decimal.Decimal) decimal.Decimal {
+		n := trim.Mul(decimal.NewFromInt(int64(len(values)))).IntPart()
+		return mean(values[n : int64(len(values))-n])
+	})
+}
+
+// perform reads the valid values from the input, rejects outliers and returns
+// the result of aggregate, which is passed the remaining values in ascending
+// order.
+func (a Aggregation) perform(input models.RunInput, aggregate func([]decimal.Decimal) decimal.Decimal) models.RunOutput {
+	values := a.values(input.Data())
+	sortDecimals(values)
+	values = a.rejectOutliers(values)
+
+	minimum := a.MinimumInputs
+	if minimum == 0 {
+		minimum = 1
+	}
+	if uint32(len(values)) < minimum {
+		return models.NewRunOutputError(fmt.Errorf("%d valid values were given, at least %d are required", len(values), minimum))
+	}
+
+	return models.NewRunOutputCompleteWithResult(aggregate(values).String())
+}
+
+func (a Aggregation) values(data models.JSON) []decimal.Decimal {
+	var array gjson.Result
+	if len(a.Path) > 0 {
+		array = data.Get(strings.Join(a.Path, "."))
+	} else if array = data.Get("results"); !array.Exists() {
+		array = data.Get("result")
+	}
+
+	if !array.IsArray() {
+		return nil
+	}
+
+	var values []decimal.Decimal
+	for _, element := range array.Array() {
+		if element.Type != gjson.Number && element.Type != gjson.String {
+			continue
+		}
+		value, err := decimal.NewFromString(element.String())
+		if err == nil {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+func (a Aggregation) rejectOutliers(sorted []decimal.Decimal) []decimal.Decimal {
+	if a.MaxDeviation == nil || len(sorted) == 0 {
+		return sorted
+	}
+
+	mid := median(sorted)
+	maxDeviation := mid.Abs().Mul(*a.MaxDeviation)
+	var accepted []decimal.Decimal
+	for _, value := range sorted {
+		if value.Sub(mid).Abs().LessThanOrEqual(maxDeviation) {
+			accepted = append(accepted, value)
+		}
+	}
+	return accepted
+}
+
+func sortDecimals(values []decimal.Decimal) {
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].LessThan(values[j])
+	})
+}
+
+func median(sorted []decimal.Decimal) decimal.Decimal {
+	k := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[k]
+	}
+	return sorted[k].Add(sorted[k-1]).Div(decimal.NewFromInt(2))
+}
+
+func mean(values []decimal.Decimal) decimal.Decimal {
+	sum := decimal.Zero
+	for _, value := range values {
+		sum = sum.Add(value)
+	}
+	return sum.Div(decimal.NewFromInt(int64(len(values))))
+}
+
+func mode(sorted []decimal.Decimal) decimal.Decimal {
+	best, bestCount := sorted[0], 0
+	for i := 0; i < len(sorted); {
+		j := i
+		for j < len(sorted) && sorted[j].Equal(sorted[i]) {
+			j++
+		}
+		if j-i > bestCount {
+			best, bestCount = sorted[i], j-i
+		}
+		i = j
+	}
+	return best
+}
@@ -0,0 +1,71 @@
+package adapters_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"chainlink/core/adapters"
+	"chainlink/core/internal/cltest"
+	"chainlink/core/store/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregation_Perform(t *testing.T) {
+	tests := []struct {
+		name    string
+		adapter string
+		params  string
+		json    string
+		want    string
+		errored bool
+	}{
+		{"median odd", "median", `{}`, `{"results":[3,1,2]}`, "2", false},
+		{"median even", "median", `{}`, `{"results":["1","2","3","10"]}`, "2.5", false},
+		{"median of result", "median", `{}`, `{"result":[5,1,3]}`, "3", false},
+		{"median with path", "median", `{"path":["prices"]}`, `{"prices":[5,1,3]}`, "3", false},
+		{"median ignores invalid", "median", `{}`, `{"results":[1,"abc",null,{"a":1},3]}`, "2", false},
+		{"median too few valid", "median", `{"minimumInputs":3}`, `{"results":[1,"abc",3]}`, "", true},
+		{"median no values", "median", `{}`, `{"result":"1"}`, "", true},
+		{"median rejects outliers", "median", `{"maxDeviation":"0.1"}`, `{"results":[100,101,99,1000,1]}`, "100", false},
+		{"mean", "mean", `{}`, `{"results":[1,2,3,4]}`, "2.5", false},
+		{"mean rejects outliers", "mean", `{"maxDeviation":0.1}`, `{"results":[100,102,98,1000]}`, "100", false},
+		{"mode", "mode", `{}`, `{"results":[1,2,2,3,3,3]}`, "3", false},
+		{"mode tie takes lowest", "mode", `{}`, `{"results":[3,3,1,1,2]}`, "1", false},
+		{"trimmedmean default", "trimmedmean", `{}`, `{"results":[1,2,3,4,5,6,7,8,9,1000]}`, "5.5", false},
+		{"trimmedmean with trim", "trimmedmean", `{"trim":0.25}`, `{"results":[1,2,3,100]}`, "2.5", false},
+		{"trimmedmean invalid trim", "trimmedmean", `{"trim":0.5}`, `{"results":[1,2,3]}`, "", true},
+	}
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			task := models.TaskSpec{Type: models.MustNewTaskType(test.adapter), Params: cltest.JSONFromString(t, test.params)}
+			adapter, err := adapters.For(task, store.Config, store.ORM)
+			require.NoError(t, err)
+
+			input := cltest.NewRunInputWithString(t, test.json)
+			result := adapter.Perform(input, nil)
+
+			if test.errored {
+				assert.Error(t, result.Error())
+			} else {
+				require.NoError(t, result.Error())
+				assert.Equal(t, test.want, result.Result().String())
+			}
+		})
+	}
+}
+
+func TestAggregation_UnmarshalsParams(t *testing.T) {
+	var adapter adapters.TrimmedMean
+	require.NoError(t, json.Unmarshal([]byte(`{"minimumInputs":2,"maxDeviation":"0.05","trim":0.2,"path":["a","b"]}`), &adapter))
+	assert.Equal(t, uint32(2), adapter.MinimumInputs)
+	assert.Equal(t, "0.05", adapter.MaxDeviation.String())
+	assert.Equal(t, "0.2", adapter.Trim.String())
+	assert.Equal(t, adapters.JSONPath{"a", "b"}, adapter.Path)
+}
@@ -109,21 +109,24 @@ func (ba *Bridge) postToExternalAdapter(input models.RunInput, bridgeResponseURL
 	client := http.Client{}
 	resp, err := client.Do(request)
 	if err != nil {
-		return nil, fmt.Errorf("POST request: %v", err)
+		return nil, errors.Wrap(BridgeConnectionError{err}, "POST request")
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode >= 400 {
 		b, _ := ioutil.ReadAll(resp.Body)
-		err = fmt.Errorf("%v %v", resp.StatusCode, string(b))
-		return nil, fmt.Errorf("POST response: %v", err)
+		err = HTTPResponseError{
+			StatusCode: resp.StatusCode,
+			message:    fmt.Sprintf("%v %v", resp.StatusCode, string(b)),
+		}
+		return nil, errors.Wrap(err, "POST response")
 	}
 
 	return ioutil.ReadAll(resp.Body)
 }
 
 func baRunResultError(str string, err error) error {
-	return fmt.Errorf("ExternalBridge %v: %v", str, err)
+	return errors.Wrapf(err, "ExternalBridge %v", str)
 }
 
 type bridgeOutgoing struct {
@@ -70,6 +70,15 @@
 // value.
 //   { "type": "Multiply", "params": {"times": 100 }}
 //
+// Median, Mean, Mode and TrimmedMean
+//
+// The aggregation adapters combine an array of values, by default the results
+// of the task's inputs, into one. Values that are not numbers are ignored,
+// values deviating from the median by more than maxDeviation are rejected, and
+// at least minimumInputs valid values are required.
+//   { "type": "Median", "params": {"minimumInputs": 3, "maxDeviation": "0.1" }}
+//   { "type": "TrimmedMean", "params": {"trim": 0.2 }}
+//
 // Random
 //
 // Random adapter generates a number between 0 and 2**256-1
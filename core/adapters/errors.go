@@ -0,0 +1,61 @@
+package adapters
+
+import (
+	"chainlink/core/store"
+	"chainlink/core/store/models"
+
+	"github.com/pkg/errors"
+)
+
+// HTTPResponseError is returned by adapters whose request received an error
+// status code in response.
+type HTTPResponseError struct {
+	StatusCode int
+	message    string
+}
+
+// Error returns the error message
+func (err HTTPResponseError) Error() string {
+	return err.message
+}
+
+// BridgeConnectionError is returned by the Bridge adapter when it could not
+// send its request to the external adapter.
+type BridgeConnectionError struct {
+	err error
+}
+
+// Error returns the error message
+func (err BridgeConnectionError) Error() string {
+	return err.err.Error()
+}
+
+// Timeout returns true if the connection failed because it timed out.
+func (err BridgeConnectionError) Timeout() bool {
+	return isTimeout(err.err)
+}
+
+// ErrorClasses returns the classes of retryable errors that an error returned
+// by an adapter belongs to, if any.
+func ErrorClasses(err error) []models.RetryableErrorClass {
+	var classes []models.RetryableErrorClass
+	switch cause := errors.Cause(err).(type) {
+	case HTTPResponseError:
+		if cause.StatusCode >= 500 {
+			classes = append(classes, models.RetryOnHTTP5xx)
+		}
+	case BridgeConnectionError:
+		classes = append(classes, models.RetryOnBridgeConnection)
+	case store.InsufficientEthError:
+		classes = append(classes, models.RetryOnInsufficientEth)
+	}
+	if isTimeout(err) {
+		classes = append(classes, models.RetryOnTimeout)
+	}
+	return classes
+}
+
+func isTimeout(err error) bool {
+	timeout, ok := errors.Cause(err).(interface{ Timeout() bool })
+	return ok && timeout.Timeout()
+}
@@ -0,0 +1,53 @@
+package adapters_test
+
+import (
+	"net/http"
+	"testing"
+
+	"chainlink/core/adapters"
+	"chainlink/core/internal/cltest"
+	"chainlink/core/store/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorClasses_HTTPResponses(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		status int
+		want   []models.RetryableErrorClass
+	}{
+		{"server error", http.StatusBadGateway, []models.RetryableErrorClass{models.RetryOnHTTP5xx}},
+		{"client error", http.StatusNotFound, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mock, cleanup := cltest.NewHTTPMockServer(t, test.status, "GET", "failed")
+			defer cleanup()
+
+			hga := adapters.HTTPGet{URL: cltest.WebURL(t, mock.URL)}
+			result := hga.Perform(models.RunInput{}, leanStore())
+
+			assert.True(t, result.HasError())
+			assert.Equal(t, "failed", result.Error().Error())
+			assert.Equal(t, test.want, adapters.ErrorClasses(result.Error()))
+		})
+	}
+}
+
+func TestErrorClasses_BridgeConnection(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	_, bt := cltest.NewBridgeType(t, "unreachable", "http://localhost:0")
+	ba := &adapters.Bridge{BridgeType: *bt}
+	result := ba.Perform(*models.NewRunInput(models.NewID(), models.JSON{}, models.RunStatusUnstarted), store)
+
+	assert.True(t, result.HasError())
+	assert.Contains(t, adapters.ErrorClasses(result.Error()), models.RetryOnBridgeConnection)
+}
@@ -3,6 +3,7 @@ package adapters
 import (
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"net"
 	"regexp"
 
@@ -16,9 +17,18 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"gopkg.in/guregu/null.v3"
 )
 
+var (
+	numberTxsReverted = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "tx_reverted",
+		Help: "The number of transactions sent by ethtx tasks that were mined but reverted",
+	})
+)
+
 const (
 	// DataFormatBytes instructs the EthTx Adapter to treat the input value as a
 	// bytes string, rather than a hexadecimal encoded bytes32
@@ -34,6 +44,8 @@ type EthTx struct {
 	DataFormat       string               `json:"format"`
 	GasPrice         *utils.Big           `json:"gasPrice" gorm:"type:numeric"`
 	GasLimit         uint64               `json:"gasLimit"`
+	SkipSimulation   bool                 `json:"skipSimulation"`
+	FromAddresses    []common.Address     `json:"fromAddresses"`
 }
 
 // Perform creates the run result for the transaction if the existing run result
@@ -55,7 +67,7 @@ func (etx *EthTx) Perform(input models.RunInput, store *strpkg.Store) models.Run
 	}
 
 	data := utils.ConcatBytes(etx.FunctionSelector.Bytes(), etx.DataPrefix, value)
-	return createTxRunResult(etx.Address, etx.GasPrice, etx.GasLimit, data, input, store)
+	return sendTx(etx.Address, etx.GasPrice, etx.GasLimit, data, etx.FromAddresses, etx.SkipSimulation, input, store)
 }
 
 // getTxData returns the data to save against the callback encoded according to
@@ -77,11 +89,52 @@ func getTxData(e *EthTx, input models.RunInput) ([]byte, error) {
 	return utils.ConcatBytes(payloadOffset, output), nil
 }
 
+// sendTx chooses the account to send the transaction from, among the
+// fromAddresses if any are given, simulates the transaction from that account
+// unless skipSimulation is set, and then sends it from that same account.
+func sendTx(
+	address common.Address,
+	gasPrice *utils.Big,
+	gasLimit uint64,
+	data []byte,
+	fromAddresses []common.Address,
+	skipSimulation bool,
+	input models.RunInput,
+	store *strpkg.Store,
+) models.RunOutput {
+	account, err := store.TxManager.NextAccount(fromAddresses)
+	if IsClientRetriable(err) {
+		return models.NewRunOutputPendingConnection()
+	} else if err != nil {
+		return models.NewRunOutputError(err)
+	}
+
+	if !skipSimulation {
+		if err := simulateTx(address, data, account.Address, store); err != nil {
+			return models.NewRunOutputError(err)
+		}
+	}
+	return createTxRunResult(address, gasPrice, gasLimit, data, account.Address, input, store)
+}
+
+// simulateTx returns an error if the transaction would revert. Other errors
+// simulating it are only logged, leaving them to surface when it is sent.
+func simulateTx(address common.Address, data []byte, from common.Address, store *strpkg.Store) error {
+	err := store.TxManager.SimulateTx(eth.CallArgs{From: &from, To: address, Data: data})
+	if _, ok := errors.Cause(err).(*eth.RevertError); ok {
+		return err
+	} else if err != nil {
+		logger.Warnw("Unable to simulate transaction, sending it anyway", "address", address.Hex(), "error", err)
+	}
+	return nil
+}
+
 func createTxRunResult(
 	address common.Address,
 	gasPrice *utils.Big,
 	gasLimit uint64,
 	data []byte,
+	from common.Address,
 	input models.RunInput,
 	store *strpkg.Store,
 ) models.RunOutput {
@@ -91,6 +144,7 @@ func createTxRunResult(
 		data,
 		gasPrice.ToInt(),
 		gasLimit,
+		[]common.Address{from},
 	)
 	if IsClientRetriable(err) {
 		return models.NewRunOutputPendingConnection()
@@ -123,7 +177,7 @@ func createTxRunResult(
 	)
 
 	if state == strpkg.Safe {
-		return addReceiptToResult(receipt, input, output)
+		return addReceiptToResult(receipt, input, output, store)
 	}
 
 	return models.NewRunOutputPendingConfirmationsWithData(output)
@@ -171,7 +225,7 @@ func ensureTxRunResult(input models.RunInput, str *strpkg.Store) models.RunOutpu
 	}
 
 	if state == strpkg.Safe {
-		return addReceiptToResult(receipt, input, output)
+		return addReceiptToResult(receipt, input, output, str)
 	}
 
 	return models.NewRunOutputPendingConfirmationsWithData(output)
@@ -181,6 +235,7 @@ func addReceiptToResult(
 	receipt *eth.TxReceipt,
 	input models.RunInput,
 	data models.JSON,
+	store *strpkg.Store,
 ) models.RunOutput {
 	receipts := []eth.TxReceipt{}
 
@@ -201,9 +256,36 @@ func addReceiptToResult(
 	if err != nil {
 		return models.NewRunOutputError(err)
 	}
+
+	if receipt.Reverted() {
+		numberTxsReverted.Inc()
+		return models.NewRunOutputErrorWithData(revertedTxError(receipt, store), data)
+	}
 	return models.NewRunOutputComplete(data)
 }
 
+// revertedTxError returns the error for a mined transaction that reverted,
+// including the revert reason if replaying the transaction finds one.
+func revertedTxError(receipt *eth.TxReceipt, store *strpkg.Store) error {
+	tx, _, err := store.FindTxByAttempt(receipt.Hash)
+	if err != nil {
+		logger.Warnw("Unable to find reverted transaction to replay", "txHash", receipt.Hash.Hex(), "error", err)
+		return fmt.Errorf("transaction %s reverted", receipt.Hash.Hex())
+	}
+
+	// eth_call at a block runs on the state after it, so replay on the block
+	// before the receipt's to see the state the transaction executed against.
+	args := eth.CallArgs{From: &tx.From, To: tx.To, Data: tx.Data}
+	blockNumber := new(big.Int).Sub(receipt.BlockNumber.ToInt(), big.NewInt(1))
+	err = store.TxManager.ReplayTx(args, blockNumber)
+	if revertErr, ok := err.(*eth.RevertError); ok && revertErr.Reason != "" {
+		return fmt.Errorf("transaction %s reverted: %s", receipt.Hash.Hex(), revertErr.Reason)
+	} else if err != nil && !ok {
+		logger.Warnw("Unable to replay reverted transaction", "txHash", receipt.Hash.Hex(), "error", err)
+	}
+	return fmt.Errorf("transaction %s reverted", receipt.Hash.Hex())
+}
+
 // IsClientRetriable does its best effort to see if an error indicates one that
 // might have a different outcome if we retried the operation
 func IsClientRetriable(err error) bool {
@@ -29,6 +29,10 @@ type EthTxABIEncode struct {
 	FunctionABI abi.Method `json:"functionABI"`
 	GasPrice    *utils.Big `json:"gasPrice" gorm:"type:numeric"`
 	GasLimit    uint64     `json:"gasLimit"`
+	// Send the transaction without first checking that it would not revert
+	SkipSimulation bool `json:"skipSimulation"`
+	// Only send the transaction from one of these accounts, if given
+	FromAddresses []common.Address `json:"fromAddresses"`
 }
 
 // UnmarshalJSON for custom JSON unmarshal that is strict, i.e. doesn't
@@ -42,8 +46,10 @@ func (etx *EthTxABIEncode) UnmarshalJSON(data []byte) error {
 			Name   string
 			Inputs abi.Arguments
 		}
-		GasPrice *utils.Big
-		GasLimit uint64
+		GasPrice       *utils.Big
+		GasLimit       uint64
+		SkipSimulation bool
+		FromAddresses  []common.Address
 	}
 
 	decoder := json.NewDecoder(bytes.NewReader(data))
@@ -57,6 +63,8 @@ func (etx *EthTxABIEncode) UnmarshalJSON(data []byte) error {
 	etx.FunctionABI.Inputs = fields.FunctionABI.Inputs
 	etx.GasPrice = fields.GasPrice
 	etx.GasLimit = fields.GasLimit
+	etx.SkipSimulation = fields.SkipSimulation
+	etx.FromAddresses = fields.FromAddresses
 	return nil
 }
 
@@ -73,7 +81,7 @@ func (etx *EthTxABIEncode) Perform(input models.RunInput, store *strpkg.Store) m
 			err = errors.Wrap(err, "while constructing EthTxABIEncode data")
 			return models.NewRunOutputError(err)
 		}
-		return createTxRunResult(etx.Address, etx.GasPrice, etx.GasLimit, data, input, store)
+		return sendTx(etx.Address, etx.GasPrice, etx.GasLimit, data, etx.FromAddresses, etx.SkipSimulation, input, store)
 	}
 	return ensureTxRunResult(input, store)
 }
@@ -4,11 +4,13 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"math/big"
 	"syscall"
 	"testing"
 
 	"chainlink/core/adapters"
+	"chainlink/core/assets"
 	"chainlink/core/eth"
 	"chainlink/core/internal/cltest"
 	"chainlink/core/internal/mocks"
@@ -16,12 +18,22 @@ import (
 	"chainlink/core/store/models"
 	"chainlink/core/utils"
 
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
+// stubNextAccount makes the TxManager send transactions from a new address,
+// which it returns.
+func stubNextAccount(txManager *mocks.TxManager) common.Address {
+	from := cltest.NewAddress()
+	txManager.On("NextAccount", mock.Anything).Return(strpkg.NewManagedAccount(accounts.Account{Address: from}, 0), nil)
+	return from
+}
+
 func TestEthTxAdapter_Perform(t *testing.T) {
 	t.Parallel()
 
@@ -86,10 +98,12 @@ func TestEthTxAdapter_Perform(t *testing.T) {
 
 			txManager := new(mocks.TxManager)
 			txManager.On("Connected").Once().Return(true)
+			from := stubNextAccount(txManager)
+			txManager.On("SimulateTx", mock.Anything).Return(nil)
 
 			tx := &models.Tx{Attempts: []*models.TxAttempt{&models.TxAttempt{}}}
 			txData := hexutil.MustDecode(test.output)
-			txManager.On("CreateTxWithGas", mock.Anything, mock.Anything, txData, gasPrice.ToInt(), gasLimit).Once().Return(tx, nil)
+			txManager.On("CreateTxWithGas", mock.Anything, mock.Anything, txData, gasPrice.ToInt(), gasLimit, []common.Address{from}).Once().Return(tx, nil)
 			txManager.On("CheckAttempt", mock.Anything, mock.Anything).Once().Return(&eth.TxReceipt{}, test.receiptState, nil)
 
 			store.TxManager = txManager
@@ -115,6 +129,8 @@ func TestEthTxAdapter_Perform_BytesFormatWithDataPrefix(t *testing.T) {
 	txManager := new(mocks.TxManager)
 	tx := &models.Tx{Attempts: []*models.TxAttempt{&models.TxAttempt{}}}
 	txManager.On("Connected").Maybe().Return(true)
+	stubNextAccount(txManager)
+	txManager.On("SimulateTx", mock.Anything).Return(nil)
 	txManager.On("CreateTxWithGas", mock.Anything, mock.Anything,
 		hexutil.MustDecode("0x"+
 			"00000000"+ // function selector
@@ -122,7 +138,7 @@ func TestEthTxAdapter_Perform_BytesFormatWithDataPrefix(t *testing.T) {
 			"0000000000000000000000000000000000000000000000000000000000000040"+ // offset
 			"000000000000000000000000000000000000000000000000000000000000000a"+ // length in bytes
 			"63c3b66e6669726d656400000000000000000000000000000000000000000000"), // encoded string left padded
-		mock.Anything, mock.Anything).Return(tx, nil)
+		mock.Anything, mock.Anything, mock.Anything).Return(tx, nil)
 	txManager.On("CheckAttempt", mock.Anything, mock.Anything).Return(&eth.TxReceipt{}, strpkg.Unconfirmed, nil)
 	store.TxManager = txManager
 
@@ -136,6 +152,119 @@ func TestEthTxAdapter_Perform_BytesFormatWithDataPrefix(t *testing.T) {
 	txManager.AssertExpectations(t)
 }
 
+func TestEthTxAdapter_Perform_Simulation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		skipSimulation bool
+		simulationErr  error
+		wantStatus     models.RunStatus
+		wantErr        string
+	}{
+		{"succeeds", false, nil, models.RunStatusPendingConfirmations, ""},
+		{"reverts", false, &eth.RevertError{Reason: "Must have a valid requestId"}, models.RunStatusErrored, "transaction would revert: Must have a valid requestId"},
+		{"cannot simulate", false, errors.New("method not found"), models.RunStatusPendingConfirmations, ""},
+		{"skipped", true, nil, models.RunStatusPendingConfirmations, ""},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			store, cleanup := cltest.NewStore(t)
+			defer cleanup()
+
+			address := cltest.NewAddress()
+			txManager := new(mocks.TxManager)
+			txManager.On("Connected").Return(true)
+			stubNextAccount(txManager)
+			if !test.skipSimulation {
+				txManager.On("SimulateTx", mock.MatchedBy(func(args eth.CallArgs) bool {
+					return args.To == address && len(args.Data) > 0
+				})).Return(test.simulationErr)
+			}
+			if test.wantErr == "" {
+				tx := &models.Tx{Attempts: []*models.TxAttempt{&models.TxAttempt{}}}
+				txManager.On("CreateTxWithGas", mock.Anything, address, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(tx, nil)
+				txManager.On("CheckAttempt", mock.Anything, mock.Anything).Return(&eth.TxReceipt{}, strpkg.Unconfirmed, nil)
+			}
+			store.TxManager = txManager
+
+			adapter := adapters.EthTx{Address: address, SkipSimulation: test.skipSimulation}
+			input := cltest.NewRunInputWithResult("0x9786856756")
+			output := adapter.Perform(input, store)
+
+			assert.Equal(t, test.wantStatus, output.Status())
+			if test.wantErr != "" {
+				assert.EqualError(t, output.Error(), test.wantErr)
+			} else {
+				assert.NoError(t, output.Error())
+			}
+
+			txManager.AssertExpectations(t)
+		})
+	}
+}
+
+func TestEthTxAdapter_Perform_FromAddresses(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	fromAddresses := []common.Address{cltest.NewAddress(), cltest.NewAddress()}
+	txManager := new(mocks.TxManager)
+	txManager.On("Connected").Return(true)
+
+	// The transaction is simulated and sent from the account chosen among the
+	// from addresses, whichever it is
+	from := fromAddresses[1]
+	txManager.On("NextAccount", fromAddresses).Return(strpkg.NewManagedAccount(accounts.Account{Address: from}, 0), nil)
+	txManager.On("SimulateTx", mock.MatchedBy(func(args eth.CallArgs) bool {
+		return args.From != nil && *args.From == from
+	})).Return(nil)
+	tx := &models.Tx{Attempts: []*models.TxAttempt{&models.TxAttempt{}}}
+	txManager.On("CreateTxWithGas", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, []common.Address{from}).Return(tx, nil)
+	txManager.On("CheckAttempt", mock.Anything, mock.Anything).Return(&eth.TxReceipt{}, strpkg.Unconfirmed, nil)
+	store.TxManager = txManager
+
+	adapter := adapters.EthTx{Address: cltest.NewAddress(), FromAddresses: fromAddresses}
+	output := adapter.Perform(cltest.NewRunInputWithResult("0x9786856756"), store)
+
+	assert.NoError(t, output.Error())
+	assert.Equal(t, models.RunStatusPendingConfirmations, output.Status())
+
+	txManager.AssertExpectations(t)
+}
+
+func TestEthTxAdapter_Perform_InsufficientEth(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	txManager := new(mocks.TxManager)
+	txManager.On("Connected").Return(true)
+	stubNextAccount(txManager)
+	txManager.On("SimulateTx", mock.Anything).Return(nil)
+	insufficientEth := strpkg.InsufficientEthError{
+		Address: cltest.NewAddress(),
+		Balance: assets.NewEth(1),
+		Cost:    assets.NewEth(2),
+	}
+	txManager.On("CreateTxWithGas", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, insufficientEth)
+	store.TxManager = txManager
+
+	adapter := adapters.EthTx{Address: cltest.NewAddress()}
+	output := adapter.Perform(cltest.NewRunInputWithResult("0x9786856756"), store)
+
+	assert.True(t, output.HasError())
+	assert.Equal(t, insufficientEth.Error(), output.Error().Error())
+	assert.Equal(t, []models.RetryableErrorClass{models.RetryOnInsufficientEth}, adapters.ErrorClasses(output.Error()))
+
+	txManager.AssertExpectations(t)
+}
+
 func TestEthTxAdapter_Perform_FromPendingConfirmations_StillPending(t *testing.T) {
 	t.Parallel()
 
@@ -194,6 +323,56 @@ func TestEthTxAdapter_Perform_FromPendingConfirmations_Safe(t *testing.T) {
 	txManager.AssertExpectations(t)
 }
 
+func TestEthTxAdapter_Perform_FromPendingConfirmations_Reverted(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		replayErr error
+		wantErr   string
+	}{
+		{"with reason", &eth.RevertError{Reason: "Must have a valid requestId"}, "reverted: Must have a valid requestId"},
+		{"without reason", &eth.RevertError{}, "reverted"},
+		{"replay fails", errors.New("missing trie node"), "reverted"},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			store, cleanup := cltest.NewStore(t)
+			defer cleanup()
+
+			from := cltest.NewAddress()
+			tx := cltest.CreateTx(t, store, from, 1)
+			status := hexutil.Uint64(0)
+			receipt := &eth.TxReceipt{Hash: tx.Attempts[0].Hash, BlockNumber: cltest.Int(129831), Status: &status}
+
+			txManager := new(mocks.TxManager)
+			txManager.On("Connected").Return(true)
+			txManager.On("BumpGasUntilSafe", mock.Anything).Return(receipt, strpkg.Safe, nil)
+			txManager.On("ReplayTx", eth.CallArgs{From: &from, To: tx.To, Data: tx.Data}, big.NewInt(129830)).Return(test.replayErr)
+			store.TxManager = txManager
+
+			adapter := adapters.EthTx{}
+			input := *models.NewRunInputWithResult(
+				models.NewID(), tx.Attempts[0].Hash, models.RunStatusPendingConfirmations,
+			)
+			output := adapter.Perform(input, store)
+
+			assert.Equal(t, models.RunStatusErrored, output.Status())
+			assert.EqualError(t, output.Error(), fmt.Sprintf("transaction %s %s", tx.Attempts[0].Hash.Hex(), test.wantErr))
+
+			receiptsJSON := output.Get("ethereumReceipts").String()
+			var receipts []eth.TxReceipt
+			require.NoError(t, json.Unmarshal([]byte(receiptsJSON), &receipts))
+			require.Len(t, receipts, 1)
+			assert.True(t, receipts[0].Reverted())
+
+			txManager.AssertExpectations(t)
+		})
+	}
+}
+
 func TestEthTxAdapter_Perform_AppendingTransactionReceipts(t *testing.T) {
 	t.Parallel()
 
@@ -240,7 +419,9 @@ func TestEthTxAdapter_Perform_WithError(t *testing.T) {
 
 	txManager := new(mocks.TxManager)
 	txManager.On("Connected").Return(true)
-	txManager.On("CreateTxWithGas", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("Cannot connect to node"))
+	stubNextAccount(txManager)
+	txManager.On("SimulateTx", mock.Anything).Return(nil)
+	txManager.On("CreateTxWithGas", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("Cannot connect to node"))
 	store.TxManager = txManager
 
 	adapter := adapters.EthTx{}
@@ -344,12 +525,15 @@ func TestEthTxAdapter_Perform_CreateTxWithGasErrorTreatsAsNotConnected(t *testin
 
 	txManager := new(mocks.TxManager)
 	txManager.On("Connected").Return(true)
+	stubNextAccount(txManager)
+	txManager.On("SimulateTx", mock.Anything).Return(nil)
 	txManager.On("CreateTxWithGas",
 		mock.Anything,
 		mock.Anything,
 		mock.Anything,
 		mock.Anything,
 		mock.Anything,
+		mock.Anything,
 	).Return(nil, syscall.ETIMEDOUT)
 	store.TxManager = txManager
 
@@ -370,12 +554,15 @@ func TestEthTxAdapter_Perform_CheckAttemptErrorTreatsAsNotConnected(t *testing.T
 
 	txManager := new(mocks.TxManager)
 	txManager.On("Connected").Return(true)
+	stubNextAccount(txManager)
+	txManager.On("SimulateTx", mock.Anything).Return(nil)
 	txManager.On("CreateTxWithGas",
 		mock.Anything,
 		mock.Anything,
 		mock.Anything,
 		mock.Anything,
 		mock.Anything,
+		mock.Anything,
 	).Return(&models.Tx{
 		Attempts: []*models.TxAttempt{&models.TxAttempt{}},
 	}, nil)
@@ -403,12 +590,15 @@ func TestEthTxAdapter_Perform_CreateTxWithEmptyResponseErrorTreatsAsPendingConfi
 	badResponseErr := errors.New("Bad response on request: [ TransactionIndex ]. Error cause was EmptyResponse, (majority count: 94 / total: 94)")
 	txManager := new(mocks.TxManager)
 	txManager.On("Connected").Return(true)
+	stubNextAccount(txManager)
+	txManager.On("SimulateTx", mock.Anything).Return(nil)
 	txManager.On("CreateTxWithGas",
 		mock.Anything,
 		mock.Anything,
 		mock.Anything,
 		mock.Anything,
 		mock.Anything,
+		mock.Anything,
 	).Return(tx, nil)
 	txManager.On("CheckAttempt", mock.Anything, mock.Anything).Return(nil, strpkg.Unknown, badResponseErr)
 	store.TxManager = txManager
@@ -441,6 +631,8 @@ func TestEthTxAdapter_Perform_NoDoubleSpendOnSendTransactionFail(t *testing.T) {
 
 	txManager := new(mocks.TxManager)
 	txManager.On("Connected").Return(true)
+	stubNextAccount(txManager)
+	txManager.On("SimulateTx", mock.Anything).Return(nil)
 	txManager.On("CreateTxWithGas",
 		mock.Anything,
 		mock.Anything,
@@ -449,6 +641,7 @@ func TestEthTxAdapter_Perform_NoDoubleSpendOnSendTransactionFail(t *testing.T) {
 			return len(data) > 0
 		}),
 		mock.Anything,
+		mock.Anything,
 		mock.Anything).Once().Return(nil, errors.New("no bueno"))
 	store.TxManager = txManager
 
@@ -466,6 +659,7 @@ func TestEthTxAdapter_Perform_NoDoubleSpendOnSendTransactionFail(t *testing.T) {
 			return bytes.Equal(sentData, data)
 		}),
 		mock.Anything,
+		mock.Anything,
 		mock.Anything).Once().Return(tx, nil)
 	txManager.On("CheckAttempt", txAttempt, uint64(0)).Return(&eth.TxReceipt{}, strpkg.Confirmed, nil)
 
@@ -3,7 +3,6 @@ package adapters
 import (
 	"bytes"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -152,7 +151,10 @@ func sendRequest(input models.RunInput, request *http.Request, limit int64) mode
 
 	responseBody := string(bytes)
 	if response.StatusCode >= 400 {
-		return models.NewRunOutputError(errors.New(responseBody))
+		return models.NewRunOutputError(HTTPResponseError{
+			StatusCode: response.StatusCode,
+			message:    responseBody,
+		})
 	}
 
 	return models.NewRunOutputCompleteWithResult(responseBody)
@@ -189,6 +189,11 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "Create Job from a Job Specification JSON",
 					Action: client.CreateJobSpec,
 				},
+				{
+					Name:   "fluxstatus",
+					Usage:  "Show what the flux monitor is doing for a Job",
+					Action: client.ShowFluxMonitorStatus,
+				},
 				{
 					Name:   "list",
 					Usage:  "List all jobs",
@@ -208,6 +213,34 @@ func NewApp(client *Client) *cli.App {
 			},
 		},
 
+		{
+			Name:  "keys",
+			Usage: "Commands for managing the node's Ethereum keys",
+			Subcommands: []cli.Command{
+				{
+					Name:   "delete",
+					Usage:  "Delete the key with the given address, so that no more transactions are sent from it",
+					Action: client.DeleteKey,
+				},
+				{
+					Name:   "export",
+					Usage:  "Export the encrypted keystore JSON of the key with the given address",
+					Action: client.ExportKey,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "output, o",
+							Usage: "file to write the keystore JSON to, instead of printing it",
+						},
+					},
+				},
+				{
+					Name:   "list",
+					Usage:  "List the node's keys with their ETH & LINK balances and next nonce",
+					Action: client.IndexKeys,
+				},
+			},
+		},
+
 		{
 			Name:        "node",
 			Aliases:     []string{"local"},
@@ -343,6 +376,11 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "get information on a specific Ethereum Transaction",
 					Action: client.ShowTransaction,
 				},
+				{
+					Name:   "cancel",
+					Usage:  "Replace an unconfirmed Ethereum Transaction with a zero value transfer to its sender, freeing its nonce",
+					Action: client.CancelTransaction,
+				},
 			},
 		},
 	}...)
@@ -140,7 +140,7 @@ type HTTPClient interface {
 	Post(string, io.Reader) (*http.Response, error)
 	Put(string, io.Reader) (*http.Response, error)
 	Patch(string, io.Reader, ...map[string]string) (*http.Response, error)
-	Delete(string) (*http.Response, error)
+	Delete(string, io.Reader) (*http.Response, error)
 }
 
 type authenticatedHTTPClient struct {
@@ -180,8 +180,8 @@ func (h *authenticatedHTTPClient) Patch(path string, body io.Reader, headers ...
 }
 
 // Delete performs an HTTP Delete using the authenticated HTTP client's cookie.
-func (h *authenticatedHTTPClient) Delete(path string) (*http.Response, error) {
-	return h.doRequest("DELETE", path, nil)
+func (h *authenticatedHTTPClient) Delete(path string, body io.Reader) (*http.Response, error) {
+	return h.doRequest("DELETE", path, body)
 }
 
 func (h *authenticatedHTTPClient) doRequest(verb, path string, body io.Reader, headerArgs ...map[string]string) (*http.Response, error) {
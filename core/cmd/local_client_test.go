@@ -83,6 +83,8 @@ func TestClient_RunNodeShowsEnv(t *testing.T) {
 	assert.Contains(t, logs, "ORACLE_CONTRACT_ADDRESS: \\n")
 	assert.Contains(t, logs, "ALLOW_ORIGINS: http://localhost:3000,http://localhost:6688\\n")
 	assert.Contains(t, logs, "BRIDGE_RESPONSE_URL: http://localhost:6688\\n")
+	assert.Contains(t, logs, "RUN_QUEUE_MAX_WORKERS: 100\\n")
+	assert.Contains(t, logs, "RUN_QUEUE_MAX_PENDING: 10000\\n")
 
 	app.AssertExpectations(t)
 }
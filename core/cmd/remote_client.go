@@ -106,7 +106,7 @@ func (cli *Client) DeleteExternalInitiator(c *clipkg.Context) error {
 		return cli.errorOut(errors.New("Must pass the name of the external initiator to delete"))
 	}
 
-	resp, err := cli.HTTP.Delete("/v2/external_initiators/" + c.Args().First())
+	resp, err := cli.HTTP.Delete("/v2/external_initiators/"+c.Args().First(), nil)
 	if err != nil {
 		return cli.errorOut(err)
 	}
@@ -153,6 +153,21 @@ func (cli *Client) ShowJobSpec(c *clipkg.Context) error {
 	return cli.renderAPIResponse(resp, &job)
 }
 
+// ShowFluxMonitorStatus shows the status of each of a job's flux monitor
+// initiators.
+func (cli *Client) ShowFluxMonitorStatus(c *clipkg.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the job id to be shown"))
+	}
+	resp, err := cli.HTTP.Get("/v2/specs/" + c.Args().First() + "/flux_monitor")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+	var statuses []presenters.FluxMonitorStatus
+	return cli.renderAPIResponse(resp, &statuses)
+}
+
 // IndexJobSpecs returns all job specs.
 func (cli *Client) IndexJobSpecs(c *clipkg.Context) error {
 	return cli.getPage("/v2/specs", c.Int("page"), &[]models.JobSpec{})
@@ -184,7 +199,7 @@ func (cli *Client) ArchiveJobSpec(c *clipkg.Context) error {
 	if !c.Args().Present() {
 		return cli.errorOut(errors.New("Must pass the job id to be archived"))
 	}
-	resp, err := cli.HTTP.Delete("/v2/specs/" + c.Args().First())
+	resp, err := cli.HTTP.Delete("/v2/specs/"+c.Args().First(), nil)
 	if err != nil {
 		return cli.errorOut(err)
 	}
@@ -290,7 +305,7 @@ func (cli *Client) RemoveBridge(c *clipkg.Context) error {
 		return cli.errorOut(errors.New("Must pass the name of the bridge to be removed"))
 	}
 	bridgeName := c.Args().First()
-	resp, err := cli.HTTP.Delete("/v2/bridge_types/" + bridgeName)
+	resp, err := cli.HTTP.Delete("/v2/bridge_types/"+bridgeName, nil)
 	if err != nil {
 		return cli.errorOut(err)
 	}
@@ -468,6 +483,22 @@ func (cli *Client) ShowTransaction(c *clipkg.Context) error {
 	return cli.renderAPIResponse(resp, &tx)
 }
 
+// CancelTransaction replaces the transaction with the given hash by a zero
+// value transfer from its sender to itself
+func (cli *Client) CancelTransaction(c *clipkg.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the hash of the transaction"))
+	}
+	hash := c.Args().First()
+	resp, err := cli.HTTP.Post("/v2/transactions/"+hash+"/cancel", nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+	var tx presenters.Tx
+	return cli.renderAPIResponse(resp, &tx)
+}
+
 // IndexTxAttempts returns the list of transactions in descending order,
 // taking an optional page parameter
 func (cli *Client) IndexTxAttempts(c *clipkg.Context) error {
@@ -586,6 +617,71 @@ func (cli *Client) CreateExtraKey(c *clipkg.Context) error {
 	return cli.printResponseBody(resp)
 }
 
+// IndexKeys lists the node's keys with their ETH & LINK balances and the
+// nonce of the next transaction sent from each
+func (cli *Client) IndexKeys(c *clipkg.Context) error {
+	resp, err := cli.HTTP.Get("/v2/keys")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+	return cli.renderAPIResponse(resp, &[]presenters.Key{})
+}
+
+// ExportKey writes the encrypted keystore JSON of the key with the given
+// address to the output file, or prints it if no output file is given
+func (cli *Client) ExportKey(c *clipkg.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the address of the key to export"))
+	}
+	request := models.ExportKeyRequest{
+		CurrentPassword: cli.PasswordPrompter.Prompt(),
+	}
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/keys/"+c.Args().First()+"/export", bytes.NewBuffer(requestData))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+
+	b, err := cli.parseResponse(resp)
+	if err != nil {
+		return err
+	}
+	if output := c.String("output"); output != "" {
+		return cli.errorOut(ioutil.WriteFile(output, b, 0600))
+	}
+	fmt.Println(string(b))
+	return nil
+}
+
+// DeleteKey deletes the key with the given address from the node, after
+// confirming the password of the node's keys
+func (cli *Client) DeleteKey(c *clipkg.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the address of the key to delete"))
+	}
+	request := models.DeleteKeyRequest{
+		CurrentPassword: cli.PasswordPrompter.Prompt(),
+	}
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Delete("/v2/keys/"+c.Args().First(), bytes.NewBuffer(requestData))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+	_, err = cli.parseResponse(resp)
+	return err
+}
+
 // SetMinimumGasPrice specifies the minimum gas price to use for outgoing transactions
 func (cli *Client) SetMinimumGasPrice(c *clipkg.Context) error {
 	if c.NArg() != 1 {
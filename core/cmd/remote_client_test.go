@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"math/big"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -19,6 +20,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
 	"github.com/urfave/cli"
 )
 
@@ -164,6 +166,28 @@ func TestClient_ShowJobSpec_NotFound(t *testing.T) {
 	assert.Empty(t, r.Renders)
 }
 
+func TestClient_ShowFluxMonitorStatus(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	job := cltest.NewJobWithFluxMonitorInitiator()
+	require.NoError(t, app.Store.CreateJob(&job))
+
+	client, r := app.NewClientAndRenderer()
+
+	set := flag.NewFlagSet("test", 0)
+	set.Parse([]string{job.ID.String()})
+	c := cli.NewContext(nil, set, nil)
+	require.NoError(t, client.ShowFluxMonitorStatus(c))
+	require.Equal(t, 1, len(r.Renders))
+	statuses := *r.Renders[0].(*[]presenters.FluxMonitorStatus)
+	require.Len(t, statuses, 1)
+	assert.Equal(t, job.Initiators[0].ID, statuses[0].InitiatorID)
+}
+
 var EndAt = time.Now().AddDate(0, 10, 0).Round(time.Second).UTC()
 
 func TestClient_CreateServiceAgreement(t *testing.T) {
@@ -817,6 +841,35 @@ func TestClient_ShowTransaction(t *testing.T) {
 	assert.Equal(t, &tx.From, renderedTx.From)
 }
 
+func TestClient_CancelTransaction(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKey(t)
+	defer cleanup()
+	ethMock, err := app.MockStartAndConnect()
+	require.NoError(t, err)
+
+	store := app.GetStore()
+	from := cltest.GetAccountAddress(t, store)
+	tx := cltest.CreateTx(t, store, from, 1)
+	ethMock.Register("eth_sendRawTransaction", cltest.NewHash())
+
+	client, r := app.NewClientAndRenderer()
+
+	set := flag.NewFlagSet("test cancel tx", 0)
+	set.Parse([]string{tx.Hash.Hex()})
+	c := cli.NewContext(nil, set, nil)
+	assert.NoError(t, client.CancelTransaction(c))
+	ethMock.EventuallyAllCalled(t)
+
+	renderedTx := *r.Renders[0].(*presenters.Tx)
+	assert.Equal(t, &tx.From, renderedTx.To)
+
+	tx, err = store.FindTx(tx.ID)
+	require.NoError(t, err)
+	assert.Len(t, tx.Attempts, 2)
+}
+
 func TestClient_IndexTxAttempts(t *testing.T) {
 	t.Parallel()
 
@@ -872,6 +925,78 @@ func TestClient_CreateExtraKey(t *testing.T) {
 	assert.NoError(t, client.CreateExtraKey(c))
 }
 
+func TestClient_IndexKeys(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKey(t)
+	defer cleanup()
+	ethMock, err := app.MockStartAndConnect()
+	require.NoError(t, err)
+	ethMock.Register("eth_getBalance", "0x0100")
+	ethMock.Register("eth_call", "0x0100")
+
+	client, r := app.NewClientAndRenderer()
+
+	assert.NoError(t, client.IndexKeys(cltest.EmptyCLIContext()))
+	keys := *r.Renders[0].(*[]presenters.Key)
+	require.Len(t, keys, 1)
+	assert.Equal(t, cltest.GetAccountAddress(t, app.Store).Hex(), keys[0].Address)
+	assert.NotNil(t, keys[0].NextNonce)
+}
+
+func TestClient_ExportKey(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKey(t)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	client, _ := app.NewClientAndRenderer()
+	client.PasswordPrompter = cltest.MockPasswordPrompter{Password: cltest.Password}
+	address := cltest.GetAccountAddress(t, app.Store)
+	output := filepath.Join(app.Config.RootDir(), "exported_key.json")
+
+	set := flag.NewFlagSet("test export key", 0)
+	set.String("output", output, "")
+	set.Parse([]string{address.Hex()})
+	c := cli.NewContext(nil, set, nil)
+	require.NoError(t, client.ExportKey(c))
+
+	exported, err := ioutil.ReadFile(output)
+	require.NoError(t, err)
+	assert.Equal(t, address, common.HexToAddress(gjson.GetBytes(exported, "address").String()))
+}
+
+func TestClient_DeleteKey(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKey(t)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	first := cltest.GetAccountAddress(t, app.Store)
+	app.AddUnlockedKey()
+	require.NoError(t, app.Store.SyncDiskKeyStoreToDB())
+	var second common.Address
+	for _, account := range app.Store.KeyStore.Accounts() {
+		if account.Address != first {
+			second = account.Address
+		}
+	}
+
+	client, _ := app.NewClientAndRenderer()
+	client.PasswordPrompter = cltest.MockPasswordPrompter{Password: cltest.Password}
+
+	set := flag.NewFlagSet("test delete key", 0)
+	set.Parse([]string{second.Hex()})
+	c := cli.NewContext(nil, set, nil)
+	require.NoError(t, client.DeleteKey(c))
+
+	accounts := app.Store.KeyStore.Accounts()
+	require.Len(t, accounts, 1)
+	assert.Equal(t, first, accounts[0].Address)
+}
+
 func TestClient_SetMinimumGasPrice(t *testing.T) {
 	t.Parallel()
 
@@ -63,8 +63,12 @@ func (rt RendererTable) Render(v interface{}) error {
 		return rt.renderBridges(*typed)
 	case *[]presenters.AccountBalance:
 		return rt.renderAccountBalances(*typed)
+	case *[]presenters.Key:
+		return rt.renderKeys(*typed)
 	case *presenters.ServiceAgreement:
 		return rt.renderServiceAgreement(*typed)
+	case *[]presenters.FluxMonitorStatus:
+		return rt.renderFluxMonitorStatuses(*typed)
 	case *[]models.TxAttempt:
 		return rt.renderTxAttempts(*typed)
 	case *[]presenters.Tx:
@@ -288,6 +292,24 @@ func (rt RendererTable) renderAccountBalances(balances []presenters.AccountBalan
 	return nil
 }
 
+func (rt RendererTable) renderKeys(keys []presenters.Key) error {
+	table := rt.newTable([]string{"Address", "ETH", "LINK", "Next Nonce"})
+	for _, k := range keys {
+		nextNonce := "inactive"
+		if k.NextNonce != nil {
+			nextNonce = strconv.FormatUint(*k.NextNonce, 10)
+		}
+		table.Append([]string{
+			k.Address,
+			k.EthBalance.String(),
+			k.LinkBalance.String(),
+			nextNonce,
+		})
+	}
+	render("Keys", table)
+	return nil
+}
+
 func (rt RendererTable) renderServiceAgreement(sa presenters.ServiceAgreement) error {
 	table := rt.newTable([]string{"ID", "Created At", "Payment", "Expiration", "Aggregator", "AggInit", "AggFulfill"})
 	table.Append([]string{
@@ -303,6 +325,25 @@ func (rt RendererTable) renderServiceAgreement(sa presenters.ServiceAgreement) e
 	return nil
 }
 
+func (rt RendererTable) renderFluxMonitorStatuses(statuses []presenters.FluxMonitorStatus) error {
+	table := rt.newTable([]string{"Initiator", "Address", "Connected", "Current Price", "Current Round", "Last Fetched Price", "Last Polled At", "Last Submitted At", "Last Error"})
+	for _, s := range statuses {
+		table.Append([]string{
+			s.GetID(),
+			s.Address.Hex(),
+			fmt.Sprint(s.Connected),
+			s.CurrentPrice.String(),
+			s.FriendlyCurrentRound(),
+			s.FriendlyLastFetchedPrice(),
+			s.FriendlyLastPolledAt(),
+			s.FriendlyLastSubmittedAt(),
+			s.FriendlyLastError(),
+		})
+	}
+	render("Flux Monitor Status", table)
+	return nil
+}
+
 func (rt RendererTable) renderExternalInitiatorAuthentication(eia presenters.ExternalInitiatorAuthentication) error {
 	table := rt.newTable([]string{"Name", "URL", "AccessKey", "Secret", "OutgoingToken", "OutgoingSecret"})
 	table.Append([]string{
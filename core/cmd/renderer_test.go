@@ -7,7 +7,9 @@ import (
 	"math/big"
 	"regexp"
 	"testing"
+	"time"
 
+	"chainlink/core/assets"
 	"chainlink/core/cmd"
 	"chainlink/core/internal/cltest"
 	"chainlink/core/store/models"
@@ -15,6 +17,7 @@ import (
 	"chainlink/core/utils"
 	"chainlink/core/web"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -59,6 +62,45 @@ func TestRendererTable_RenderShowJob(t *testing.T) {
 	assert.NoError(t, r.Render(&p))
 }
 
+func TestRendererTable_RenderKeys(t *testing.T) {
+	t.Parallel()
+	buffer := bytes.NewBufferString("")
+	r := cmd.RendererTable{Writer: buffer}
+	nonce := uint64(7)
+	keys := []presenters.Key{
+		{Address: cltest.NewAddress().Hex(), EthBalance: assets.NewEth(1), LinkBalance: assets.NewLink(2), NextNonce: &nonce},
+		{Address: cltest.NewAddress().Hex(), EthBalance: assets.NewEth(0), LinkBalance: assets.NewLink(0)},
+	}
+	require.NoError(t, r.Render(&keys))
+
+	output := buffer.String()
+	assert.Contains(t, output, keys[0].Address)
+	assert.Contains(t, output, "7")
+	assert.Contains(t, output, "inactive")
+}
+
+func TestRendererTable_RenderFluxMonitorStatuses(t *testing.T) {
+	t.Parallel()
+	r := cmd.RendererTable{Writer: ioutil.Discard}
+	now := time.Now()
+	price := decimal.NewFromInt(101)
+	statuses := []presenters.FluxMonitorStatus{
+		{FluxMonitorStatus: models.FluxMonitorStatus{InitiatorID: 1, Address: cltest.NewAddress()}},
+		{FluxMonitorStatus: models.FluxMonitorStatus{
+			InitiatorID:      2,
+			Address:          cltest.NewAddress(),
+			Connected:        true,
+			CurrentPrice:     decimal.NewFromInt(100),
+			CurrentRound:     utils.NewBig(big.NewInt(3)),
+			LastFetchedPrice: &price,
+			LastPolledAt:     &now,
+			LastError:        "unable to fetch median price",
+			LastErrorAt:      &now,
+		}},
+	}
+	assert.NoError(t, r.Render(&statuses))
+}
+
 func TestRenderer_RenderJobRun(t *testing.T) {
 	t.Parallel()
 
@@ -1,9 +1,12 @@
 package eth
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"math/big"
+	"regexp"
+	"strings"
 
 	"chainlink/core/assets"
 	"chainlink/core/utils"
@@ -11,6 +14,7 @@ import (
 	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
 )
@@ -28,12 +32,18 @@ type Client interface {
 	LogSubscriber
 	GetNonce(address common.Address) (uint64, error)
 	GetEthBalance(address common.Address) (*assets.Eth, error)
+	GetEthBalances(addresses []common.Address) ([]*assets.Eth, error)
 	GetERC20Balance(address common.Address, contractAddress common.Address) (*big.Int, error)
 	GetAggregatorPrice(address common.Address, precision int32) (decimal.Decimal, error)
 	GetAggregatorRound(address common.Address) (*big.Int, error)
+	GetAggregatorRoundState(address common.Address, oracle common.Address) (AggregatorRoundState, error)
+	EstimateGas(args CallArgs) (uint64, error)
+	SimulateTx(args CallArgs) error
+	ReplayTx(args CallArgs, blockNumber *big.Int) error
 	SendRawTx(hex string) (common.Hash, error)
 	GetTxReceipt(hash common.Hash) (*TxReceipt, error)
 	GetBlockByNumber(hex string) (BlockHeader, error)
+	GetBlockWithTransactions(hex string) (Block, error)
 	GetChainID() (*big.Int, error)
 	SubscribeToNewHeads(channel chan<- BlockHeader) (Subscription, error)
 }
@@ -41,6 +51,7 @@ type Client interface {
 // LogSubscriber encapsulates only the methods needed for subscribing to ethereum log events.
 type LogSubscriber interface {
 	GetLogs(q ethereum.FilterQuery) ([]Log, error)
+	GetLogsBatch(qs []ethereum.FilterQuery) ([][]Log, error)
 	SubscribeToLogs(channel chan<- Log, q ethereum.FilterQuery) (Subscription, error)
 }
 
@@ -68,6 +79,26 @@ type CallerSubscriber interface {
 	Subscribe(context.Context, interface{}, ...interface{}) (Subscription, error)
 }
 
+// BatchCaller is implemented by CallerSubscribers that can send several
+// JSON-RPC calls to the ethereum node in a single request.
+type BatchCaller interface {
+	BatchCall(b []rpc.BatchElem) error
+}
+
+// BatchCall sends the given JSON-RPC calls in a single batch request if the
+// CallerSubscriber supports it, and one by one otherwise. The error of each
+// call is set on its BatchElem, and an error is only returned if the request
+// could not be sent.
+func (client *CallerSubscriberClient) BatchCall(b []rpc.BatchElem) error {
+	if batchCaller, ok := client.CallerSubscriber.(BatchCaller); ok {
+		return batchCaller.BatchCall(b)
+	}
+	for i := range b {
+		b[i].Error = client.Call(b[i].Result, b[i].Method, b[i].Args...)
+	}
+	return nil
+}
+
 // GetNonce returns the nonce (transaction count) for a given address.
 func (client *CallerSubscriberClient) GetNonce(address common.Address) (uint64, error) {
 	result := ""
@@ -90,12 +121,122 @@ func (client *CallerSubscriberClient) GetEthBalance(address common.Address) (*as
 	return amount, nil
 }
 
+// GetEthBalances returns the balances of the given addresses in Ether, looked
+// up in a single batch request.
+func (client *CallerSubscriberClient) GetEthBalances(addresses []common.Address) ([]*assets.Eth, error) {
+	results := make([]string, len(addresses))
+	batch := make([]rpc.BatchElem, len(addresses))
+	for i, address := range addresses {
+		batch[i] = rpc.BatchElem{
+			Method: "eth_getBalance",
+			Args:   []interface{}{address.Hex(), "latest"},
+			Result: &results[i],
+		}
+	}
+	if err := client.BatchCall(batch); err != nil {
+		return nil, err
+	}
+
+	balances := make([]*assets.Eth, len(addresses))
+	for i, elem := range batch {
+		if elem.Error != nil {
+			return nil, errors.Wrapf(elem.Error, "eth_getBalance %s", addresses[i].Hex())
+		}
+		balance, ok := new(assets.Eth).SetString(results[i], 0)
+		if !ok {
+			return nil, fmt.Errorf("unable to parse eth_getBalance %s result %q", addresses[i].Hex(), results[i])
+		}
+		balances[i] = balance
+	}
+	return balances, nil
+}
+
 // CallArgs represents the data used to call the balance method of an ERC
 // contract. "To" is the address of the ERC contract. "Data" is the message sent
-// to the contract.
+// to the contract. "From" is the optional sender of the message.
 type CallArgs struct {
-	To   common.Address `json:"to"`
-	Data hexutil.Bytes  `json:"data"`
+	From *common.Address `json:"from,omitempty"`
+	To   common.Address  `json:"to"`
+	Data hexutil.Bytes   `json:"data"`
+}
+
+// EstimateGas returns the amount of gas the node estimates a transaction
+// with the given arguments would use.
+func (client *CallerSubscriberClient) EstimateGas(args CallArgs) (uint64, error) {
+	result := ""
+	err := client.Call(&result, "eth_estimateGas", args)
+	if err != nil {
+		return 0, err
+	}
+	return utils.HexToUint64(result)
+}
+
+// RevertError is returned when a transaction would be reverted by the EVM.
+type RevertError struct {
+	Reason string
+}
+
+func (e *RevertError) Error() string {
+	if e.Reason == "" {
+		return "transaction would revert"
+	}
+	return fmt.Sprintf("transaction would revert: %s", e.Reason)
+}
+
+var (
+	// errorStringSelector is the selector of Error(string), which solidity
+	// prepends to the reason given to require and revert.
+	errorStringSelector = HexToFunctionSelector("0x08c379a0")
+	revertMessageRegex  = regexp.MustCompile(`(?i)(execution reverted|reverted|vm execution error)`)
+)
+
+// SimulateTx executes a transaction with the given arguments against the
+// latest block using eth_call, without sending it. It returns a RevertError
+// if the transaction would revert.
+func (client *CallerSubscriberClient) SimulateTx(args CallArgs) error {
+	return client.executeTx(args, "latest")
+}
+
+// ReplayTx executes a mined transaction with the given arguments again using
+// eth_call on the state of the given block, returning a RevertError with the
+// reason it reverted.
+func (client *CallerSubscriberClient) ReplayTx(args CallArgs, blockNumber *big.Int) error {
+	return client.executeTx(args, hexutil.EncodeBig(blockNumber))
+}
+
+func (client *CallerSubscriberClient) executeTx(args CallArgs, block string) error {
+	result := ""
+	err := client.Call(&result, "eth_call", args, block)
+	if err != nil && revertMessageRegex.MatchString(err.Error()) {
+		return &RevertError{Reason: strings.TrimPrefix(err.Error(), "execution reverted: ")}
+	} else if err != nil {
+		return err
+	}
+
+	output, err := hexutil.Decode(result)
+	if err != nil {
+		return nil
+	}
+	if reason, ok := parseRevertReason(output); ok {
+		return &RevertError{Reason: reason}
+	}
+	return nil
+}
+
+// parseRevertReason returns the reason encoded in the output of a call that
+// reverted with Error(string).
+func parseRevertReason(output []byte) (string, bool) {
+	if len(output) < FunctionSelectorLength+2*utils.EVMWordByteLen ||
+		!bytes.Equal(output[:FunctionSelectorLength], errorStringSelector.Bytes()) {
+		return "", false
+	}
+
+	payload := output[FunctionSelectorLength:]
+	length := new(big.Int).SetBytes(payload[utils.EVMWordByteLen : 2*utils.EVMWordByteLen])
+	if !length.IsUint64() || length.Uint64() > uint64(len(payload)-2*utils.EVMWordByteLen) {
+		return "", false
+	}
+	return string(payload[2*utils.EVMWordByteLen : 2*utils.EVMWordByteLen+int(length.Uint64())]), true
 }
 
 // GetERC20Balance returns the balance of the given address for the token contract address.
@@ -182,6 +323,138 @@ func (client *CallerSubscriberClient) GetAggregatorRound(address common.Address)
 	return round, nil
 }
 
+// AggregatorRoundState holds the state of an aggregator's rounds, as seen by
+// one of its oracles, needed to tell whether the oracle can answer a round.
+type AggregatorRoundState struct {
+	// ReportingRound is the round most recently started.
+	ReportingRound *big.Int
+	// ReportingRoundFinished is true once the reporting round has an answer.
+	ReportingRoundFinished bool
+	// ReportingRoundTimedOut is true once the reporting round has run for
+	// longer than the aggregator's timeout, whether or not the aggregator has
+	// recorded it as timed out yet.
+	ReportingRoundTimedOut bool
+	// LastReportedRound is the last round the oracle answered.
+	LastReportedRound *big.Int
+	AvailableFunds    *big.Int
+	PaymentAmount     *big.Int
+}
+
+// GetAggregatorRoundState returns the state of the rounds of the aggregator
+// at the given address, as seen by the given oracle.
+func (client *CallerSubscriberClient) GetAggregatorRoundState(address common.Address, oracle common.Address) (AggregatorRoundState, error) {
+	state := AggregatorRoundState{}
+	var err error
+	if state.ReportingRound, err = client.callAggregatorUint(address, "reportingRound"); err != nil {
+		return state, err
+	}
+	updatedAt, err := client.callAggregatorUint(address, "getTimestamp", state.ReportingRound)
+	if err != nil {
+		return state, err
+	}
+	state.ReportingRoundFinished = updatedAt.Sign() > 0
+	timedOut, err := client.callAggregatorUint(address, "getTimedOutStatus", state.ReportingRound)
+	if err != nil {
+		return state, err
+	}
+	state.ReportingRoundTimedOut = timedOut.Sign() > 0
+	if !state.ReportingRoundFinished && !state.ReportingRoundTimedOut && state.ReportingRound.Sign() > 0 {
+		state.ReportingRoundTimedOut, err = client.aggregatorRoundExpired(address, state.ReportingRound)
+		if err != nil {
+			return state, err
+		}
+	}
+
+	submission, err := client.callAggregator(address, "latestSubmission", oracle)
+	if err != nil {
+		return state, err
+	}
+	words, err := hexutil.Decode(submission)
+	if err != nil || len(words) != 2*utils.EVMWordByteLen {
+		return state, fmt.Errorf("unable to parse latestSubmission %s from aggregator %s", submission, address.Hex())
+	}
+	state.LastReportedRound = new(big.Int).SetBytes(words[utils.EVMWordByteLen:])
+
+	if state.AvailableFunds, err = client.callAggregatorUint(address, "availableFunds"); err != nil {
+		return state, err
+	}
+	if state.PaymentAmount, err = client.callAggregatorUint(address, "paymentAmount"); err != nil {
+		return state, err
+	}
+	return state, nil
+}
+
+// aggregatorRoundExpired tells whether the round has run for longer than the
+// aggregator's timeout, from the block of its NewRound log to the latest
+// block. The aggregator itself only records a round as timed out once the
+// next round starts.
+func (client *CallerSubscriberClient) aggregatorRoundExpired(address common.Address, round *big.Int) (bool, error) {
+	timeout, err := client.callAggregatorUint(address, "timeout")
+	if err != nil || timeout.Sign() == 0 {
+		return false, err
+	}
+
+	aggregator, err := GetV5Contract(PrepaidAggregatorName)
+	if err != nil {
+		return false, errors.Wrap(err, "unable to get contract "+PrepaidAggregatorName)
+	}
+	newRound, found := aggregator.ABI.Events["NewRound"]
+	if !found {
+		return false, fmt.Errorf("unable to find event NewRound for contract %s", PrepaidAggregatorName)
+	}
+	logs, err := client.GetLogs(ethereum.FilterQuery{
+		Addresses: []common.Address{address},
+		Topics:    [][]common.Hash{{newRound.ID()}, {common.BigToHash(round)}},
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "unable to fetch NewRound log for round %s of aggregator %s", round, address.Hex())
+	} else if len(logs) == 0 {
+		return false, fmt.Errorf("unable to find NewRound log for round %s of aggregator %s", round, address.Hex())
+	}
+
+	startedIn, err := client.GetBlockByNumber(hexutil.EncodeUint64(logs[len(logs)-1].BlockNumber))
+	if err != nil {
+		return false, errors.Wrapf(err, "unable to fetch block starting round %s of aggregator %s", round, address.Hex())
+	}
+	latest, err := client.GetBlockByNumber("latest")
+	if err != nil {
+		return false, errors.Wrap(err, "unable to fetch latest block")
+	}
+	expiresAt := new(big.Int).Add(startedIn.Time.ToInt(), timeout)
+	return expiresAt.Cmp(latest.Time.ToInt()) < 0, nil
+}
+
+// callAggregator calls a view function of the aggregator at the given
+// address, returning the raw result.
+func (client *CallerSubscriberClient) callAggregator(address common.Address, method string, args ...interface{}) (string, error) {
+	aggregator, err := GetV5Contract(PrepaidAggregatorName)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to get contract "+PrepaidAggregatorName)
+	}
+	data, err := aggregator.EncodeMessageCall(method, args...)
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to encode %s message for contract %s", method, PrepaidAggregatorName)
+	}
+
+	var result string
+	err = client.Call(&result, "eth_call", CallArgs{To: address, Data: data}, "latest")
+	return result, errors.Wrapf(err, "unable to call %s on aggregator %s", method, address.Hex())
+}
+
+// callAggregatorUint calls a view function of the aggregator at the given
+// address returning a single unsigned integer or boolean.
+func (client *CallerSubscriberClient) callAggregatorUint(address common.Address, method string, args ...interface{}) (*big.Int, error) {
+	result, err := client.callAggregator(address, method, args...)
+	if err != nil {
+		return nil, err
+	}
+	value, ok := new(big.Int).SetString(result, 0)
+	if !ok {
+		return nil, fmt.Errorf("unable to parse int from %s returned by %s on aggregator %s", result, method, address.Hex())
+	}
+	return value, nil
+}
+
 // SendRawTx sends a signed transaction to the transaction pool.
 func (client *CallerSubscriberClient) SendRawTx(hex string) (common.Hash, error) {
 	result := common.Hash{}
@@ -203,6 +476,14 @@ func (client *CallerSubscriberClient) GetBlockByNumber(hex string) (BlockHeader,
 	return header, err
 }
 
+// GetBlockWithTransactions returns the block for the passed hex encoded block
+// number, including its transactions.
+func (client *CallerSubscriberClient) GetBlockWithTransactions(hex string) (Block, error) {
+	var block Block
+	err := client.Call(&block, "eth_getBlockByNumber", hex, true)
+	return block, err
+}
+
 // GetLogs returns all logs that respect the passed filter query.
 func (client *CallerSubscriberClient) GetLogs(q ethereum.FilterQuery) ([]Log, error) {
 	var results []Log
@@ -210,6 +491,30 @@ func (client *CallerSubscriberClient) GetLogs(q ethereum.FilterQuery) ([]Log, er
 	return results, err
 }
 
+// GetLogsBatch returns the logs respecting each of the passed filter queries,
+// retrieved in a single batch request.
+func (client *CallerSubscriberClient) GetLogsBatch(qs []ethereum.FilterQuery) ([][]Log, error) {
+	results := make([][]Log, len(qs))
+	batch := make([]rpc.BatchElem, len(qs))
+	for i, q := range qs {
+		batch[i] = rpc.BatchElem{
+			Method: "eth_getLogs",
+			Args:   []interface{}{utils.ToFilterArg(q)},
+			Result: &results[i],
+		}
+	}
+	if err := client.BatchCall(batch); err != nil {
+		return nil, err
+	}
+
+	for _, elem := range batch {
+		if elem.Error != nil {
+			return nil, errors.Wrap(elem.Error, "eth_getLogs")
+		}
+	}
+	return results, nil
+}
+
 // GetChainID returns the ethereum ChainID.
 func (client *CallerSubscriberClient) GetChainID() (*big.Int, error) {
 	value := new(utils.Big)
@@ -2,16 +2,24 @@ package eth_test
 
 import (
 	"encoding/json"
+	"fmt"
 	"testing"
 
 	"math/big"
 
+	"chainlink/core/assets"
 	"chainlink/core/eth"
 	"chainlink/core/internal/cltest"
 	"chainlink/core/internal/mocks"
 	strpkg "chainlink/core/store"
+	"chainlink/core/store/models"
+	"chainlink/core/utils"
 
+	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -92,6 +100,103 @@ func TestCallerSubscriberClient_GetNonce(t *testing.T) {
 	assert.Equal(t, result, expected)
 }
 
+func TestCallerSubscriberClient_EstimateGas(t *testing.T) {
+	caller := new(mocks.CallerSubscriber)
+	ethClient := &eth.CallerSubscriberClient{CallerSubscriber: caller}
+	from := cltest.NewAddress()
+	args := eth.CallArgs{From: &from, To: cltest.NewAddress(), Data: []byte{1, 2, 3}}
+
+	caller.On("Call", mock.Anything, "eth_estimateGas", args).Return(nil).
+		Run(func(args mock.Arguments) {
+			res := args.Get(0).(*string)
+			*res = "0x5208"
+		})
+	result, err := ethClient.EstimateGas(args)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(21000), result)
+	caller.AssertExpectations(t)
+}
+
+func TestCallerSubscriberClient_SimulateTx(t *testing.T) {
+	t.Parallel()
+
+	revertData := "0x08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"000000000000000000000000000000000000000000000000000000000000001b" +
+		"4d757374206861766520612076616c6964207265717565737449640000000000"
+
+	tests := []struct {
+		name       string
+		result     string
+		callErr    error
+		wantErr    string
+		wantRevert bool
+	}{
+		{"succeeds", "0x0000000000000000000000000000000000000000000000000000000000000001", nil, "", false},
+		{"succeeds without output", "0x", nil, "", false},
+		{"reverts with reason in output", revertData, nil, "transaction would revert: Must have a valid requestId", true},
+		{"reverts with reason in error", "", errors.New("execution reverted: Must have a valid requestId"), "transaction would revert: Must have a valid requestId", true},
+		{"reverts without reason", "", errors.New("VM execution error."), "transaction would revert: VM execution error.", true},
+		{"fails", "", errors.New("method not found"), "method not found", false},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			caller := new(mocks.CallerSubscriber)
+			ethClient := &eth.CallerSubscriberClient{CallerSubscriber: caller}
+			args := eth.CallArgs{To: cltest.NewAddress(), Data: []byte{1, 2, 3}}
+
+			caller.On("Call", mock.Anything, "eth_call", args, "latest").Return(test.callErr).
+				Run(func(args mock.Arguments) {
+					res := args.Get(0).(*string)
+					*res = test.result
+				})
+
+			err := ethClient.SimulateTx(args)
+			if test.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.wantErr)
+			}
+			_, isRevert := err.(*eth.RevertError)
+			assert.Equal(t, test.wantRevert, isRevert)
+			caller.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCallerSubscriberClient_ReplayTx(t *testing.T) {
+	caller := new(mocks.CallerSubscriber)
+	ethClient := &eth.CallerSubscriberClient{CallerSubscriber: caller}
+	from := cltest.NewAddress()
+	args := eth.CallArgs{From: &from, To: cltest.NewAddress(), Data: []byte{1, 2, 3}}
+
+	caller.On("Call", mock.Anything, "eth_call", args, "0x1fb27").
+		Return(errors.New("execution reverted: Must have a valid requestId"))
+	err := ethClient.ReplayTx(args, big.NewInt(129831))
+	assert.Equal(t, &eth.RevertError{Reason: "Must have a valid requestId"}, err)
+	caller.AssertExpectations(t)
+}
+
+func TestCallerSubscriberClient_GetBlockWithTransactions(t *testing.T) {
+	caller := new(mocks.CallerSubscriber)
+	ethClient := &eth.CallerSubscriberClient{CallerSubscriber: caller}
+
+	caller.On("Call", mock.Anything, "eth_getBlockByNumber", "0x10", true).Return(nil).
+		Run(func(args mock.Arguments) {
+			block := args.Get(0).(*eth.Block)
+			block.Number = hexutil.Big(*big.NewInt(16))
+			block.Transactions = []eth.Transaction{{GasPrice: hexutil.Big(*big.NewInt(20000000000))}}
+		})
+	block, err := ethClient.GetBlockWithTransactions("0x10")
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(16), block.Number.ToInt())
+	require.Len(t, block.Transactions, 1)
+	assert.Equal(t, big.NewInt(20000000000), block.Transactions[0].GasPrice.ToInt())
+	caller.AssertExpectations(t)
+}
+
 func TestCallerSubscriberClient_SendRawTx(t *testing.T) {
 	t.Parallel()
 	app, cleanup := cltest.NewApplicationWithKey(t)
@@ -134,6 +239,101 @@ func TestCallerSubscriberClient_GetEthBalance(t *testing.T) {
 	}
 }
 
+func TestCallerSubscriberClient_GetEthBalances(t *testing.T) {
+	t.Parallel()
+
+	caller := new(mocks.CallerSubscriber)
+	ethClient := &eth.CallerSubscriberClient{CallerSubscriber: caller}
+	addresses := []common.Address{cltest.NewAddress(), cltest.NewAddress()}
+
+	for i, address := range addresses {
+		balance := fmt.Sprintf("0x%x", i+1)
+		caller.On("Call", mock.Anything, "eth_getBalance", address.Hex(), "latest").Return(nil).
+			Run(func(args mock.Arguments) {
+				res := args.Get(0).(*string)
+				*res = balance
+			})
+	}
+
+	balances, err := ethClient.GetEthBalances(addresses)
+	require.NoError(t, err)
+	assert.Equal(t, []*assets.Eth{assets.NewEth(1), assets.NewEth(2)}, balances)
+	caller.AssertExpectations(t)
+}
+
+func TestCallerSubscriberClient_GetEthBalances_InvalidBalance(t *testing.T) {
+	t.Parallel()
+
+	caller := new(mocks.CallerSubscriber)
+	ethClient := &eth.CallerSubscriberClient{CallerSubscriber: caller}
+	address := cltest.NewAddress()
+
+	caller.On("Call", mock.Anything, "eth_getBalance", address.Hex(), "latest").Return(nil).
+		Run(func(args mock.Arguments) {
+			res := args.Get(0).(*string)
+			*res = "0xnotanumber"
+		})
+
+	_, err := ethClient.GetEthBalances([]common.Address{address})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unable to parse eth_getBalance")
+}
+
+type batchCallerSubscriber struct {
+	*mocks.CallerSubscriber
+	batches [][]rpc.BatchElem
+	respond func(elem *rpc.BatchElem)
+}
+
+func (b *batchCallerSubscriber) BatchCall(batch []rpc.BatchElem) error {
+	b.batches = append(b.batches, batch)
+	for i := range batch {
+		b.respond(&batch[i])
+	}
+	return nil
+}
+
+func TestCallerSubscriberClient_GetLogsBatch(t *testing.T) {
+	t.Parallel()
+
+	address := cltest.NewAddress()
+	caller := &batchCallerSubscriber{
+		CallerSubscriber: new(mocks.CallerSubscriber),
+		respond: func(elem *rpc.BatchElem) {
+			arg := elem.Args[0].(map[string]interface{})
+			*elem.Result.(*[]eth.Log) = []eth.Log{{Address: address, Data: []byte(arg["fromBlock"].(string))}}
+		},
+	}
+	ethClient := &eth.CallerSubscriberClient{CallerSubscriber: caller}
+
+	qs := []ethereum.FilterQuery{
+		{FromBlock: big.NewInt(1), ToBlock: big.NewInt(10), Addresses: []common.Address{address}},
+		{FromBlock: big.NewInt(11), ToBlock: big.NewInt(20), Addresses: []common.Address{address}},
+	}
+	logs, err := ethClient.GetLogsBatch(qs)
+	require.NoError(t, err)
+
+	require.Len(t, caller.batches, 1, "should send the queries in one batch")
+	require.Len(t, logs, 2)
+	assert.Equal(t, []byte("0x1"), logs[0][0].Data)
+	assert.Equal(t, []byte("0xb"), logs[1][0].Data)
+}
+
+func TestCallerSubscriberClient_GetLogsBatch_Error(t *testing.T) {
+	t.Parallel()
+
+	caller := &batchCallerSubscriber{
+		CallerSubscriber: new(mocks.CallerSubscriber),
+		respond: func(elem *rpc.BatchElem) {
+			elem.Error = errors.New("query returned more than 10000 results")
+		},
+	}
+	ethClient := &eth.CallerSubscriberClient{CallerSubscriber: caller}
+
+	_, err := ethClient.GetLogsBatch([]ethereum.FilterQuery{{FromBlock: big.NewInt(1)}})
+	assert.EqualError(t, err, "eth_getLogs: query returned more than 10000 results")
+}
+
 func TestCallerSubscriberClient_GetERC20Balance(t *testing.T) {
 	t.Parallel()
 	app, cleanup := cltest.NewApplicationWithKey(t)
@@ -236,3 +436,98 @@ func TestCallerSubscriberClient_GetAggregatorRound(t *testing.T) {
 		})
 	}
 }
+
+func TestCallerSubscriberClient_GetAggregatorRoundState(t *testing.T) {
+	caller := new(mocks.CallerSubscriber)
+	ethClient := &eth.CallerSubscriberClient{CallerSubscriber: caller}
+	address := cltest.NewAddress()
+	oracle := cltest.NewAddress()
+
+	word := func(n int64) string {
+		return hexutil.Encode(utils.EVMWordUint64(uint64(n)))
+	}
+	responses := map[string]string{
+		"6fb4bb4e": word(7),                 // reportingRound()
+		"b633620c": word(0),                 // getTimestamp(7)
+		"25b6ae00": word(1),                 // getTimedOutStatus(7)
+		"bb07bacd": word(100) + word(6)[2:], // latestSubmission(oracle)
+		"46fcff4c": word(50),                // availableFunds()
+		"c35905c6": word(2),                 // paymentAmount()
+	}
+	for selector, response := range responses {
+		selector, response := selector, response
+		caller.On("Call", mock.Anything, "eth_call", mock.MatchedBy(func(args eth.CallArgs) bool {
+			return args.To == address && hexutil.Encode(args.Data[:4]) == "0x"+selector
+		}), "latest").Return(nil).
+			Run(func(args mock.Arguments) {
+				res := args.Get(0).(*string)
+				*res = response
+			})
+	}
+
+	state, err := ethClient.GetAggregatorRoundState(address, oracle)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(7), state.ReportingRound)
+	assert.False(t, state.ReportingRoundFinished)
+	assert.True(t, state.ReportingRoundTimedOut)
+	assert.Equal(t, big.NewInt(6), state.LastReportedRound)
+	assert.Equal(t, big.NewInt(50), state.AvailableFunds)
+	assert.Equal(t, big.NewInt(2), state.PaymentAmount)
+	caller.AssertExpectations(t)
+}
+
+func TestCallerSubscriberClient_GetAggregatorRoundState_UnrecordedTimeout(t *testing.T) {
+	caller := new(mocks.CallerSubscriber)
+	ethClient := &eth.CallerSubscriberClient{CallerSubscriber: caller}
+	address := cltest.NewAddress()
+	oracle := cltest.NewAddress()
+
+	word := func(n int64) string {
+		return hexutil.Encode(utils.EVMWordUint64(uint64(n)))
+	}
+	responses := map[string]string{
+		"6fb4bb4e": word(7),                 // reportingRound()
+		"b633620c": word(0),                 // getTimestamp(7)
+		"25b6ae00": word(0),                 // getTimedOutStatus(7)
+		"70dea79a": word(60),                // timeout()
+		"bb07bacd": word(100) + word(7)[2:], // latestSubmission(oracle)
+		"46fcff4c": word(50),                // availableFunds()
+		"c35905c6": word(2),                 // paymentAmount()
+	}
+	for selector, response := range responses {
+		selector, response := selector, response
+		caller.On("Call", mock.Anything, "eth_call", mock.MatchedBy(func(args eth.CallArgs) bool {
+			return args.To == address && hexutil.Encode(args.Data[:4]) == "0x"+selector
+		}), "latest").Return(nil).
+			Run(func(args mock.Arguments) {
+				res := args.Get(0).(*string)
+				*res = response
+			})
+	}
+
+	// Round 7 was started at time 1000, and the latest block is past its timeout
+	caller.On("Call", mock.Anything, "eth_getLogs", mock.Anything).Return(nil).
+		Run(func(args mock.Arguments) {
+			arg := args.Get(2).(map[string]interface{})
+			assert.Equal(t, [][]common.Hash{
+				{models.AggregatorNewRoundLogTopic20191220},
+				{common.BigToHash(big.NewInt(7))},
+			}, arg["topics"])
+			*args.Get(0).(*[]eth.Log) = []eth.Log{{BlockNumber: 5}}
+		})
+	caller.On("Call", mock.Anything, "eth_getBlockByNumber", "0x5", false).Return(nil).
+		Run(func(args mock.Arguments) {
+			*args.Get(0).(*eth.BlockHeader) = eth.BlockHeader{Time: cltest.BigHexInt(1000)}
+		})
+	caller.On("Call", mock.Anything, "eth_getBlockByNumber", "latest", false).Return(nil).
+		Run(func(args mock.Arguments) {
+			*args.Get(0).(*eth.BlockHeader) = eth.BlockHeader{Time: cltest.BigHexInt(1061)}
+		})
+
+	state, err := ethClient.GetAggregatorRoundState(address, oracle)
+	require.NoError(t, err)
+	assert.False(t, state.ReportingRoundFinished)
+	assert.True(t, state.ReportingRoundTimedOut)
+	assert.Equal(t, big.NewInt(7), state.LastReportedRound)
+	caller.AssertExpectations(t)
+}
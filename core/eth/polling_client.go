@@ -0,0 +1,204 @@
+package eth
+
+import (
+	"math/big"
+	"sync"
+	"time"
+
+	"chainlink/core/logger"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+)
+
+// PollingClient is a Client for ethereum nodes that cannot push subscriptions,
+// such as those reached over http. Its log and new head subscriptions poll
+// the node instead, with eth_getLogs and eth_blockNumber respectively.
+type PollingClient struct {
+	*CallerSubscriberClient
+	interval   time.Duration
+	blockRange uint64
+	reorgDepth uint64
+}
+
+// NewPollingClient returns a PollingClient polling the node every interval,
+// and looking up the logs of at most blockRange blocks per eth_getLogs call.
+// The logs of the last reorgDepth blocks already polled are looked up again
+// on every poll, to pick up logs mined again by a chain reorganization.
+func NewPollingClient(client *CallerSubscriberClient, interval time.Duration, blockRange, reorgDepth uint64) *PollingClient {
+	if blockRange == 0 {
+		blockRange = 1
+	}
+	return &PollingClient{
+		CallerSubscriberClient: client,
+		interval:               interval,
+		blockRange:             blockRange,
+		reorgDepth:             reorgDepth,
+	}
+}
+
+// logID identifies a log by the block it was mined in, so that a log mined
+// again in another block by a chain reorganization is told apart.
+type logID struct {
+	blockHash common.Hash
+	txHash    common.Hash
+	index     uint
+}
+
+// SubscribeToLogs polls for the logs matching the filter query in the blocks
+// mined after the subscription is made, or from the filter's FromBlock if it
+// is later. The last reorgDepth blocks are polled again, and the logs in them
+// that were not already sent are sent, such as those mined again in another
+// block after a chain reorganization. Unlike a pushed subscription, logs
+// removed by a chain reorganization are not sent again as removed.
+func (client *PollingClient) SubscribeToLogs(channel chan<- Log, q ethereum.FilterQuery) (Subscription, error) {
+	latest, err := client.blockNumber()
+	if err != nil {
+		return nil, err
+	}
+	next := latest + 1
+	if q.FromBlock != nil && q.FromBlock.Sign() > 0 && q.FromBlock.Uint64() > next {
+		next = q.FromBlock.Uint64()
+	}
+	start := next
+	sent := map[logID]uint64{}
+
+	return startPolling(client.interval, "logs", func(done <-chan struct{}) error {
+		latest, err := client.blockNumber()
+		if err != nil {
+			return err
+		}
+		if q.ToBlock != nil && q.ToBlock.Sign() >= 0 && q.ToBlock.Uint64() < latest {
+			latest = q.ToBlock.Uint64()
+		}
+
+		from := start
+		if next > start+client.reorgDepth {
+			from = next - client.reorgDepth
+		}
+		for from <= latest {
+			end := from + client.blockRange - 1
+			if end > latest {
+				end = latest
+			}
+			chunk := q
+			chunk.FromBlock = new(big.Int).SetUint64(from)
+			chunk.ToBlock = new(big.Int).SetUint64(end)
+			logs, err := client.GetLogs(chunk)
+			if err != nil {
+				return errors.Wrapf(err, "eth_getLogs from block %d to %d", from, end)
+			}
+
+			for _, log := range logs {
+				id := logID{blockHash: log.BlockHash, txHash: log.TxHash, index: log.Index}
+				if _, ok := sent[id]; ok {
+					continue
+				}
+				select {
+				case <-done:
+					return nil
+				case channel <- log:
+					sent[id] = log.BlockNumber
+				}
+			}
+			from = end + 1
+			if from > next {
+				next = from
+			}
+		}
+
+		// Forget the logs of blocks that are no longer polled again
+		for id, blockNumber := range sent {
+			if blockNumber+client.reorgDepth < next {
+				delete(sent, id)
+			}
+		}
+		return nil
+	}), nil
+}
+
+// SubscribeToNewHeads polls for new heads, sending the latest head whenever
+// the block number of the node changes. Heads mined in between polls are
+// skipped, like any other missed heads.
+func (client *PollingClient) SubscribeToNewHeads(channel chan<- BlockHeader) (Subscription, error) {
+	last, err := client.blockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	return startPolling(client.interval, "newHeads", func(done <-chan struct{}) error {
+		latest, err := client.blockNumber()
+		if err != nil || latest == last {
+			return err
+		}
+
+		header, err := client.GetBlockByNumber(hexutil.EncodeUint64(latest))
+		if err != nil {
+			return errors.Wrapf(err, "eth_getBlockByNumber %d", latest)
+		}
+		select {
+		case <-done:
+		case channel <- header:
+			last = latest
+		}
+		return nil
+	}), nil
+}
+
+func (client *PollingClient) blockNumber() (uint64, error) {
+	var number hexutil.Uint64
+	err := client.Call(&number, "eth_blockNumber")
+	return uint64(number), errors.Wrap(err, "eth_blockNumber")
+}
+
+// pollingSubscription is a Subscription calling its poll function every
+// interval until it is unsubscribed. Failed polls are retried on the next
+// interval rather than ending the subscription, so its error channel only
+// ever closes.
+type pollingSubscription struct {
+	errors          chan error
+	done            chan struct{}
+	wg              sync.WaitGroup
+	unsubscribeOnce sync.Once
+}
+
+func startPolling(interval time.Duration, name string, poll func(done <-chan struct{}) error) *pollingSubscription {
+	sub := &pollingSubscription{
+		errors: make(chan error),
+		done:   make(chan struct{}),
+	}
+
+	sub.wg.Add(1)
+	go func() {
+		defer sub.wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-sub.done:
+				return
+			case <-ticker.C:
+				if err := poll(sub.done); err != nil {
+					logger.Warnw("Failed to poll ethereum node", "subscription", name, "error", err)
+				}
+			}
+		}
+	}()
+	return sub
+}
+
+// Err returns a channel that is closed on Unsubscribe.
+func (sub *pollingSubscription) Err() <-chan error {
+	return sub.errors
+}
+
+// Unsubscribe stops polling.
+func (sub *pollingSubscription) Unsubscribe() {
+	sub.unsubscribeOnce.Do(func() {
+		close(sub.done)
+		sub.wg.Wait()
+		close(sub.errors)
+	})
+}
@@ -0,0 +1,145 @@
+package eth_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"chainlink/core/eth"
+	"chainlink/core/internal/cltest"
+	"chainlink/core/internal/mocks"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func returnBlockNumber(number uint64) func(mock.Arguments) {
+	return func(args mock.Arguments) {
+		*args.Get(0).(*hexutil.Uint64) = hexutil.Uint64(number)
+	}
+}
+
+func TestPollingClient_SubscribeToLogs_ChunksBlockRange(t *testing.T) {
+	t.Parallel()
+
+	caller := new(mocks.CallerSubscriber)
+	client := eth.NewPollingClient(&eth.CallerSubscriberClient{CallerSubscriber: caller}, 10*time.Millisecond, 1000, 0)
+	caller.On("Call", mock.Anything, "eth_blockNumber").Return(nil).Run(returnBlockNumber(10)).Once()
+	caller.On("Call", mock.Anything, "eth_blockNumber").Return(nil).Run(returnBlockNumber(2505))
+
+	ranges := make(chan [2]string, 3)
+	caller.On("Call", mock.Anything, "eth_getLogs", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		arg := args.Get(2).(map[string]interface{})
+		ranges <- [2]string{arg["fromBlock"].(string), arg["toBlock"].(string)}
+		fromBlock, err := hexutil.DecodeUint64(arg["fromBlock"].(string))
+		require.NoError(t, err)
+		*args.Get(0).(*[]eth.Log) = []eth.Log{{BlockNumber: fromBlock}}
+	})
+
+	address := cltest.NewAddress()
+	logs := make(chan eth.Log)
+	sub, err := client.SubscribeToLogs(logs, ethereum.FilterQuery{Addresses: []common.Address{address}})
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	for _, expected := range [][2]uint64{{11, 1010}, {1011, 2010}, {2011, 2505}} {
+		cltest.CallbackOrTimeout(t, "chunk of logs polled", func() {
+			assert.Equal(t, expected[0], (<-logs).BlockNumber)
+			assert.Equal(t, [2]string{
+				hexutil.EncodeUint64(expected[0]),
+				hexutil.EncodeUint64(expected[1]),
+			}, <-ranges)
+		})
+	}
+}
+
+func TestPollingClient_SubscribeToLogs_PollsReorgDepthAgain(t *testing.T) {
+	t.Parallel()
+
+	caller := new(mocks.CallerSubscriber)
+	client := eth.NewPollingClient(&eth.CallerSubscriberClient{CallerSubscriber: caller}, 10*time.Millisecond, 1000, 2)
+	caller.On("Call", mock.Anything, "eth_blockNumber").Return(nil).Run(returnBlockNumber(10)).Once()
+	caller.On("Call", mock.Anything, "eth_blockNumber").Return(nil).Run(returnBlockNumber(12))
+
+	// The log is mined again in another block 12 after a chain reorganization
+	txHash := cltest.NewHash()
+	mined := eth.Log{BlockNumber: 12, BlockHash: cltest.NewHash(), TxHash: txHash}
+	reorged := eth.Log{BlockNumber: 12, BlockHash: cltest.NewHash(), TxHash: txHash}
+	returnLogs := func(logs ...eth.Log) func(mock.Arguments) {
+		return func(args mock.Arguments) {
+			arg := args.Get(2).(map[string]interface{})
+			assert.Equal(t, "0xb", arg["fromBlock"])
+			assert.Equal(t, "0xc", arg["toBlock"])
+			*args.Get(0).(*[]eth.Log) = logs
+		}
+	}
+	caller.On("Call", mock.Anything, "eth_getLogs", mock.Anything).Return(nil).Run(returnLogs(mined)).Once()
+	caller.On("Call", mock.Anything, "eth_getLogs", mock.Anything).Return(nil).Run(returnLogs(reorged))
+
+	logs := make(chan eth.Log)
+	sub, err := client.SubscribeToLogs(logs, ethereum.FilterQuery{})
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	cltest.CallbackOrTimeout(t, "log polled", func() {
+		assert.Equal(t, mined.BlockHash, (<-logs).BlockHash)
+	})
+	cltest.CallbackOrTimeout(t, "reorganized log polled again", func() {
+		assert.Equal(t, reorged.BlockHash, (<-logs).BlockHash)
+	})
+
+	// Logs already sent are not sent again
+	select {
+	case log := <-logs:
+		t.Fatalf("unexpected log %v", log)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestPollingClient_SubscribeToLogs_StartsFromBlock(t *testing.T) {
+	t.Parallel()
+
+	caller := new(mocks.CallerSubscriber)
+	client := eth.NewPollingClient(&eth.CallerSubscriberClient{CallerSubscriber: caller}, 10*time.Millisecond, 1000, 0)
+	caller.On("Call", mock.Anything, "eth_blockNumber").Return(nil).Run(returnBlockNumber(100))
+
+	q := ethereum.FilterQuery{FromBlock: big.NewInt(120)}
+	sub, err := client.SubscribeToLogs(make(chan eth.Log), q)
+	require.NoError(t, err)
+
+	// No logs are requested until the node reaches the filter's FromBlock
+	time.Sleep(50 * time.Millisecond)
+	sub.Unsubscribe()
+	caller.AssertNotCalled(t, "Call", mock.Anything, "eth_getLogs", mock.Anything)
+}
+
+func TestPollingClient_SubscribeToNewHeads(t *testing.T) {
+	t.Parallel()
+
+	caller := new(mocks.CallerSubscriber)
+	client := eth.NewPollingClient(&eth.CallerSubscriberClient{CallerSubscriber: caller}, 10*time.Millisecond, 1000, 0)
+	caller.On("Call", mock.Anything, "eth_blockNumber").Return(nil).Run(returnBlockNumber(10)).Once()
+	caller.On("Call", mock.Anything, "eth_blockNumber").Return(nil).Run(returnBlockNumber(12))
+	caller.On("Call", mock.Anything, "eth_getBlockByNumber", "0xc", false).Return(nil).Run(func(args mock.Arguments) {
+		*args.Get(0).(*eth.BlockHeader) = eth.BlockHeader{Number: cltest.BigHexInt(12)}
+	}).Once()
+
+	heads := make(chan eth.BlockHeader)
+	sub, err := client.SubscribeToNewHeads(heads)
+	require.NoError(t, err)
+
+	cltest.CallbackOrTimeout(t, "new head polled", func() {
+		assert.Equal(t, cltest.BigHexInt(12), (<-heads).Number)
+	})
+
+	// The same head is not sent again
+	time.Sleep(50 * time.Millisecond)
+	sub.Unsubscribe()
+	_, open := <-sub.Err()
+	assert.False(t, open)
+	caller.AssertExpectations(t)
+}
@@ -93,6 +93,20 @@ type BlockHeader struct {
 
 var emptyHash = common.Hash{}
 
+// Block represents a block in the Ethereum blockchain along with the
+// transactions it includes.
+type Block struct {
+	Number       hexutil.Big   `json:"number"`
+	Transactions []Transaction `json:"transactions"`
+}
+
+// Transaction represents the fields of a transaction in a block used by the
+// node.
+type Transaction struct {
+	Hash     common.Hash `json:"hash"`
+	GasPrice hexutil.Big `json:"gasPrice"`
+}
+
 // Hash will return GethHash if it exists otherwise it returns the ParityHash
 func (h BlockHeader) Hash() common.Hash {
 	if h.GethHash != emptyHash {
@@ -104,10 +118,11 @@ func (h BlockHeader) Hash() common.Hash {
 // TxReceipt holds the block number and the transaction hash of a signed
 // transaction that has been written to the blockchain.
 type TxReceipt struct {
-	BlockNumber *utils.Big   `json:"blockNumber"`
-	BlockHash   *common.Hash `json:"blockHash"`
-	Hash        common.Hash  `json:"transactionHash"`
-	Logs        []Log        `json:"logs"`
+	BlockNumber *utils.Big      `json:"blockNumber"`
+	BlockHash   *common.Hash    `json:"blockHash"`
+	Hash        common.Hash     `json:"transactionHash"`
+	Logs        []Log           `json:"logs"`
+	Status      *hexutil.Uint64 `json:"status,omitempty"`
 }
 
 // Unconfirmed returns true if the transaction is not confirmed.
@@ -115,6 +130,12 @@ func (txr *TxReceipt) Unconfirmed() bool {
 	return txr.Hash == emptyHash || txr.BlockNumber == nil
 }
 
+// Reverted returns true if the transaction was mined but reverted. Receipts
+// from before the Byzantium fork have no status, and are never reverted.
+func (txr *TxReceipt) Reverted() bool {
+	return txr.Status != nil && *txr.Status == 0
+}
+
 // ChainlinkFulfilledTopic is the signature for the event emitted after calling
 // ChainlinkClient.validateChainlinkCallback(requestId).
 // https://chainlink/blob/master/evm/contracts/ChainlinkClient.sol
@@ -4,6 +4,7 @@ import (
 	"chainlink/core/eth"
 	"chainlink/core/internal/cltest"
 	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -46,6 +47,34 @@ func TestReceipt_UnmarshalEmptyBlockHash(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestReceipt_Reverted(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		status string
+		want   bool
+	}{
+		{"succeeded", `"status": "0x1",`, false},
+		{"reverted", `"status": "0x0",`, true},
+		{"pre-byzantium", ``, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			input := fmt.Sprintf(`{
+				%s
+				"transactionHash": "0x444172bef57ad978655171a8af2cfd89baa02a97fcb773067aef7794d6913374",
+				"blockNumber": "0x8bf99b"
+			}`, test.status)
+
+			var receipt eth.TxReceipt
+			require.NoError(t, json.Unmarshal([]byte(input), &receipt))
+			assert.Equal(t, test.want, receipt.Reverted())
+		})
+	}
+}
+
 func TestModels_HexToFunctionSelector(t *testing.T) {
 	t.Parallel()
 	fid := eth.HexToFunctionSelector("0xb3f98adc")
@@ -104,9 +104,11 @@ func NewTestConfig(t testing.TB) *TestConfig {
 	rootdir := filepath.Join(RootDir, fmt.Sprintf("%d-%d", time.Now().UnixNano(), count))
 	rawConfig := orm.NewConfig()
 	rawConfig.Set("BRIDGE_RESPONSE_URL", "http://localhost:6688")
+	rawConfig.Set("BALANCE_MONITOR_ENABLED", false)
 	rawConfig.Set("ETH_CHAIN_ID", 3)
 	rawConfig.Set("CHAINLINK_DEV", true)
 	rawConfig.Set("ETH_GAS_BUMP_THRESHOLD", 3)
+	rawConfig.Set("LOG_BACKFILL_MAX_RETRIES", 0)
 	rawConfig.Set("LOG_LEVEL", orm.LogLevel{Level: zapcore.DebugLevel})
 	rawConfig.Set("LOG_SQL", false)
 	rawConfig.Set("LOG_SQL_MIGRATIONS", false)
@@ -485,7 +487,7 @@ func (r *HTTPClientCleaner) Patch(path string, body io.Reader, headers ...map[st
 }
 
 func (r *HTTPClientCleaner) Delete(path string) (*http.Response, func()) {
-	resp, err := r.HTTPClient.Delete(path)
+	resp, err := r.HTTPClient.Delete(path, nil)
 	return bodyCleaner(r.t, resp, err)
 }
 
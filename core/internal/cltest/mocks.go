@@ -77,6 +77,11 @@ func (mock *EthMock) Dial(url string) (eth.CallerSubscriber, error) {
 	return mock, nil
 }
 
+// DialHTTP mock dial
+func (mock *EthMock) DialHTTP(url string) (eth.CallerSubscriber, error) {
+	return mock, nil
+}
+
 // Context adds helpful context to EthMock values set in the callback function.
 func (mock *EthMock) Context(context string, callback func(*EthMock)) {
 	mock.context = context
@@ -605,6 +610,8 @@ type MockHeadTrackable struct {
 	ConnectedCallback func(bn *models.Head)
 	disconnectedCount int32
 	onNewHeadCount    int32
+	onReorgCount      int32
+	ReorgCallback     func(ancestor *models.Head, oldHeads, newHeads []models.Head)
 }
 
 // Connect increases the connected count by one
@@ -637,6 +644,19 @@ func (m *MockHeadTrackable) OnNewHeadCount() int32 {
 	return atomic.LoadInt32(&m.onNewHeadCount)
 }
 
+// OnReorg increases the OnReorgCount count by one
+func (m *MockHeadTrackable) OnReorg(ancestor *models.Head, oldHeads, newHeads []models.Head) {
+	atomic.AddInt32(&m.onReorgCount, 1)
+	if m.ReorgCallback != nil {
+		m.ReorgCallback(ancestor, oldHeads, newHeads)
+	}
+}
+
+// OnReorgCount returns the count of reorganizations, safely.
+func (m *MockHeadTrackable) OnReorgCount() int32 {
+	return atomic.LoadInt32(&m.onReorgCount)
+}
+
 // NeverSleeper is a struct that never sleeps
 type NeverSleeper struct{}
 
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -20,6 +21,7 @@ import (
 	"chainlink/core/web"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tidwall/gjson"
@@ -82,6 +84,8 @@ func TestIntegration_HttpRequestWithHeaders(t *testing.T) {
 	eth.EventuallyAllCalled(t)
 
 	eth.Context("ethTx.Perform()#1 at block 23456", func(eth *cltest.EthMock) {
+		eth.Register("eth_call", "0x") // Simulated before sending
+		eth.Register("eth_estimateGas", "0x186a0")
 		eth.Register("eth_sendRawTransaction", attempt1Hash) // Initial tx attempt sent
 		eth.Register("eth_getTransactionReceipt", unconfirmedReceipt)
 	})
@@ -97,6 +101,7 @@ func TestIntegration_HttpRequestWithHeaders(t *testing.T) {
 		eth.Register("eth_getTransactionReceipt", confirmedReceipt) // confirmed for gas bumped txat
 		eth.Register("eth_getBalance", "0x0100")
 		eth.Register("eth_call", "0x0100")
+		eth.Register("eth_getBlockByNumber", ethpkg.Block{}) // GasPriceEstimator.OnNewHead()
 	})
 	newHeads <- ethpkg.BlockHeader{Number: cltest.BigHexInt(safe)} // 23465
 	eth.EventuallyAllCalled(t)
@@ -158,6 +163,8 @@ func TestIntegration_FeeBump(t *testing.T) {
 	// This first run of the EthTx adapter creates an initial transaction which
 	// starts unconfirmed
 	eth.Context("ethTx.Perform()#1", func(eth *cltest.EthMock) {
+		eth.Register("eth_call", "0x") // Simulated before sending
+		eth.Register("eth_estimateGas", "0x186a0")
 		eth.Register("eth_sendRawTransaction", attempt1Hash)
 		eth.Register("eth_getTransactionReceipt", unconfirmedReceipt)
 	})
@@ -169,6 +176,7 @@ func TestIntegration_FeeBump(t *testing.T) {
 	// At the next head, the transaction is still unconfirmed, but no thresholds
 	// have been met so we just wait...
 	eth.Context("ethTx.Perform()#2", func(eth *cltest.EthMock) {
+		eth.Register("eth_getBlockByNumber", ethpkg.Block{}) // GasPriceEstimator.OnNewHead()
 		eth.Register("eth_getTransactionReceipt", unconfirmedReceipt)
 	})
 	newHeads <- ethpkg.BlockHeader{Number: cltest.BigHexInt(firstTxRemainsUnconfirmedAt)}
@@ -179,6 +187,7 @@ func TestIntegration_FeeBump(t *testing.T) {
 	// threshold has been met, so a new transaction is made with a higher amount
 	// of gas ("bumped gas")
 	eth.Context("ethTx.Perform()#3", func(eth *cltest.EthMock) {
+		eth.Register("eth_getBlockByNumber", ethpkg.Block{}) // GasPriceEstimator.OnNewHead()
 		eth.Register("eth_getTransactionReceipt", unconfirmedReceipt)
 		eth.Register("eth_sendRawTransaction", attempt2Hash)
 	})
@@ -190,6 +199,7 @@ func TestIntegration_FeeBump(t *testing.T) {
 	// Another head comes in and both transactions are still unconfirmed, more
 	// waiting...
 	eth.Context("ethTx.Perform()#4", func(eth *cltest.EthMock) {
+		eth.Register("eth_getBlockByNumber", ethpkg.Block{}) // GasPriceEstimator.OnNewHead()
 		eth.Register("eth_getTransactionReceipt", unconfirmedReceipt)
 		eth.Register("eth_getTransactionReceipt", unconfirmedReceipt)
 	})
@@ -200,6 +210,7 @@ func TestIntegration_FeeBump(t *testing.T) {
 	// Now the second transaction attempt meets the gas bump threshold, so a
 	// final transaction attempt shoud be made
 	eth.Context("ethTx.Perform()#5", func(eth *cltest.EthMock) {
+		eth.Register("eth_getBlockByNumber", ethpkg.Block{}) // GasPriceEstimator.OnNewHead()
 		eth.Register("eth_getTransactionReceipt", unconfirmedReceipt)
 		eth.Register("eth_getTransactionReceipt", unconfirmedReceipt)
 		eth.Register("eth_sendRawTransaction", attempt3Hash)
@@ -212,6 +223,7 @@ func TestIntegration_FeeBump(t *testing.T) {
 	// This third attempt has enough gas and gets confirmed, but has not yet
 	// received sufficient confirmations, so we wait again...
 	eth.Context("ethTx.Perform()#6", func(eth *cltest.EthMock) {
+		eth.Register("eth_getBlockByNumber", ethpkg.Block{}) // GasPriceEstimator.OnNewHead()
 		eth.Register("eth_getTransactionReceipt", thirdTxConfirmedReceipt)
 	})
 	newHeads <- ethpkg.BlockHeader{Number: cltest.BigHexInt(thirdTxConfirmedAt)}
@@ -221,6 +233,7 @@ func TestIntegration_FeeBump(t *testing.T) {
 	// Finally the third attempt gets to a minimum number of safe confirmations,
 	// the amount remaining in the account is printed (eth_getBalance, eth_call)
 	eth.Context("ethTx.Perform()#7", func(eth *cltest.EthMock) {
+		eth.Register("eth_getBlockByNumber", ethpkg.Block{}) // GasPriceEstimator.OnNewHead()
 		eth.Register("eth_getTransactionReceipt", thirdTxConfirmedReceipt)
 		eth.Register("eth_getBalance", "0x100")
 		eth.Register("eth_call", "0x100")
@@ -907,7 +920,14 @@ func TestIntegration_FluxMonitor_Deviation(t *testing.T) {
 		BlockNumber: cltest.Int(1),
 	}
 
+	// Round 1 is finished and answered, so the FM submits to round 2.
+	eth.Context("Flux Monitor checks round state", func(mock *cltest.EthMock) {
+		registerAggregatorRoundState(mock, 1, true, 1)
+	})
+
 	eth.Context("ethTx.Perform() for initial send", func(eth *cltest.EthMock) {
+		eth.Register("eth_call", "0x") // Simulated before sending
+		eth.Register("eth_estimateGas", "0x186a0")
 		eth.Register("eth_sendRawTransaction", attemptHash)         // Initial tx attempt sent
 		eth.Register("eth_getTransactionReceipt", confirmedReceipt) // confirmed for gas bumped txat
 	})
@@ -926,6 +946,7 @@ func TestIntegration_FluxMonitor_Deviation(t *testing.T) {
 
 	// Send a head w block number 10, high enough to mark ethtx as safe.
 	eth.Context("ethTx.Perform() for safe", func(eth *cltest.EthMock) {
+		eth.Register("eth_getBlockByNumber", ethpkg.Block{}) // GasPriceEstimator.OnNewHead()
 		eth.Register("eth_getTransactionReceipt", confirmedReceipt)
 		eth.Register("eth_getBalance", "0x100")
 		eth.Register("eth_call", "0x100")
@@ -996,7 +1017,13 @@ func TestIntegration_FluxMonitor_NewRound(t *testing.T) {
 		Hash:        attemptHash,
 		BlockNumber: cltest.Int(1),
 	}
+	// Round 9 from the log is in progress and not yet answered by this node.
+	eth.Context("Flux Monitor checks round state", func(mock *cltest.EthMock) {
+		registerAggregatorRoundState(mock, 9, false, 8)
+	})
 	eth.Context("ethTx.Perform() for new round send", func(eth *cltest.EthMock) {
+		eth.Register("eth_call", "0x") // Simulated before sending
+		eth.Register("eth_estimateGas", "0x186a0")
 		eth.Register("eth_sendRawTransaction", attemptHash)         // Initial tx attempt sent
 		eth.Register("eth_getTransactionReceipt", confirmedReceipt) // confirmed for gas bumped txat
 	})
@@ -1005,3 +1032,27 @@ func TestIntegration_FluxMonitor_NewRound(t *testing.T) {
 	_ = cltest.WaitForJobRunToPendConfirmations(t, app.Store, jrs[0])
 	eth.EventuallyAllCalled(t)
 }
+
+// registerAggregatorRoundState registers the aggregator calls made to fetch
+// its round state, with plenty of funds to pay for answers and no round
+// timeout.
+func registerAggregatorRoundState(mock *cltest.EthMock, reportingRound int64, finished bool, lastReportedRound int64) {
+	updatedAt := "0x0"
+	if finished {
+		updatedAt = "0x5e0be0e4"
+	}
+	latestSubmission := hexutil.Encode(utils.ConcatBytes(
+		common.BigToHash(big.NewInt(100)).Bytes(),
+		common.BigToHash(big.NewInt(lastReportedRound)).Bytes(),
+	))
+
+	mock.Register("eth_call", hexutil.EncodeBig(big.NewInt(reportingRound))) // reportingRound
+	mock.Register("eth_call", updatedAt)                                     // getTimestamp
+	mock.Register("eth_call", "0x0")                                         // getTimedOutStatus
+	if !finished {
+		mock.Register("eth_call", "0x0") // timeout, none set
+	}
+	mock.Register("eth_call", latestSubmission)    // latestSubmission
+	mock.Register("eth_call", "0xde0b6b3a7640000") // availableFunds
+	mock.Register("eth_call", "0x1")               // paymentAmount
+}
@@ -3,9 +3,11 @@
 package mocks
 
 import big "math/big"
+import eth "chainlink/core/eth"
 import mock "github.com/stretchr/testify/mock"
 import models "chainlink/core/store/models"
 import packr "github.com/gobuffalo/packr"
+import services "chainlink/core/services"
 
 import store "chainlink/core/store"
 
@@ -79,6 +81,34 @@ func (_m *Application) Cancel(runID *models.ID) (*models.JobRun, error) {
 	return r0, r1
 }
 
+// CancelRunForCancelledTx provides a mock function with given fields: tx
+func (_m *Application) CancelRunForCancelledTx(tx *models.Tx) error {
+	ret := _m.Called(tx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Tx) error); ok {
+		r0 = rf(tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CancelRunsForRemovedLog provides a mock function with given fields: initiator, log
+func (_m *Application) CancelRunsForRemovedLog(initiator models.Initiator, log eth.Log) error {
+	ret := _m.Called(initiator, log)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(models.Initiator, eth.Log) error); ok {
+		r0 = rf(initiator, log)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Create provides a mock function with given fields: jobSpecID, initiator, data, creationHeight, runRequest
 func (_m *Application) Create(jobSpecID *models.ID, initiator *models.Initiator, data *models.JSON, creationHeight *big.Int, runRequest *models.RunRequest) (*models.JobRun, error) {
 	ret := _m.Called(jobSpecID, initiator, data, creationHeight, runRequest)
@@ -125,6 +155,22 @@ func (_m *Application) CreateErrored(jobSpecID *models.ID, initiator models.Init
 	return r0, r1
 }
 
+// GetFluxMonitor provides a mock function with given fields:
+func (_m *Application) GetFluxMonitor() services.FluxMonitor {
+	ret := _m.Called()
+
+	var r0 services.FluxMonitor
+	if rf, ok := ret.Get(0).(func() services.FluxMonitor); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(services.FluxMonitor)
+		}
+	}
+
+	return r0
+}
+
 // GetStore provides a mock function with given fields:
 func (_m *Application) GetStore() *store.Store {
 	ret := _m.Called()
@@ -141,6 +187,20 @@ func (_m *Application) GetStore() *store.Store {
 	return r0
 }
 
+// InvalidateOrphanedRuns provides a mock function with given fields: orphanedHeads
+func (_m *Application) InvalidateOrphanedRuns(orphanedHeads []models.Head) error {
+	ret := _m.Called(orphanedHeads)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]models.Head) error); ok {
+		r0 = rf(orphanedHeads)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewBox provides a mock function with given fields:
 func (_m *Application) NewBox() packr.Box {
 	ret := _m.Called()
@@ -197,6 +257,20 @@ func (_m *Application) ResumeAllInProgress() error {
 	return r0
 }
 
+// ResumeAllRetrying provides a mock function with given fields:
+func (_m *Application) ResumeAllRetrying() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ResumePending provides a mock function with given fields: runID, input
 func (_m *Application) ResumePending(runID *models.ID, input models.BridgeRunResult) error {
 	ret := _m.Called(runID, input)
@@ -15,6 +15,27 @@ type Client struct {
 	mock.Mock
 }
 
+// EstimateGas provides a mock function with given fields: args
+func (_m *Client) EstimateGas(args eth.CallArgs) (uint64, error) {
+	ret := _m.Called(args)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(eth.CallArgs) uint64); ok {
+		r0 = rf(args)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(eth.CallArgs) error); ok {
+		r1 = rf(args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAggregatorPrice provides a mock function with given fields: address, precision
 func (_m *Client) GetAggregatorPrice(address common.Address, precision int32) (decimal.Decimal, error) {
 	ret := _m.Called(address, precision)
@@ -59,6 +80,27 @@ func (_m *Client) GetAggregatorRound(address common.Address) (*big.Int, error) {
 	return r0, r1
 }
 
+// GetAggregatorRoundState provides a mock function with given fields: address, oracle
+func (_m *Client) GetAggregatorRoundState(address common.Address, oracle common.Address) (eth.AggregatorRoundState, error) {
+	ret := _m.Called(address, oracle)
+
+	var r0 eth.AggregatorRoundState
+	if rf, ok := ret.Get(0).(func(common.Address, common.Address) eth.AggregatorRoundState); ok {
+		r0 = rf(address, oracle)
+	} else {
+		r0 = ret.Get(0).(eth.AggregatorRoundState)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, common.Address) error); ok {
+		r1 = rf(address, oracle)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBlockByNumber provides a mock function with given fields: hex
 func (_m *Client) GetBlockByNumber(hex string) (eth.BlockHeader, error) {
 	ret := _m.Called(hex)
@@ -80,6 +122,27 @@ func (_m *Client) GetBlockByNumber(hex string) (eth.BlockHeader, error) {
 	return r0, r1
 }
 
+// GetBlockWithTransactions provides a mock function with given fields: hex
+func (_m *Client) GetBlockWithTransactions(hex string) (eth.Block, error) {
+	ret := _m.Called(hex)
+
+	var r0 eth.Block
+	if rf, ok := ret.Get(0).(func(string) eth.Block); ok {
+		r0 = rf(hex)
+	} else {
+		r0 = ret.Get(0).(eth.Block)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(hex)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetChainID provides a mock function with given fields:
 func (_m *Client) GetChainID() (*big.Int, error) {
 	ret := _m.Called()
@@ -149,6 +212,29 @@ func (_m *Client) GetEthBalance(address common.Address) (*assets.Eth, error) {
 	return r0, r1
 }
 
+// GetEthBalances provides a mock function with given fields: addresses
+func (_m *Client) GetEthBalances(addresses []common.Address) ([]*assets.Eth, error) {
+	ret := _m.Called(addresses)
+
+	var r0 []*assets.Eth
+	if rf, ok := ret.Get(0).(func([]common.Address) []*assets.Eth); ok {
+		r0 = rf(addresses)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*assets.Eth)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]common.Address) error); ok {
+		r1 = rf(addresses)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetLogs provides a mock function with given fields: q
 func (_m *Client) GetLogs(q ethereum.FilterQuery) ([]eth.Log, error) {
 	ret := _m.Called(q)
@@ -172,6 +258,29 @@ func (_m *Client) GetLogs(q ethereum.FilterQuery) ([]eth.Log, error) {
 	return r0, r1
 }
 
+// GetLogsBatch provides a mock function with given fields: qs
+func (_m *Client) GetLogsBatch(qs []ethereum.FilterQuery) ([][]eth.Log, error) {
+	ret := _m.Called(qs)
+
+	var r0 [][]eth.Log
+	if rf, ok := ret.Get(0).(func([]ethereum.FilterQuery) [][]eth.Log); ok {
+		r0 = rf(qs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([][]eth.Log)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]ethereum.FilterQuery) error); ok {
+		r1 = rf(qs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetNonce provides a mock function with given fields: address
 func (_m *Client) GetNonce(address common.Address) (uint64, error) {
 	ret := _m.Called(address)
@@ -216,6 +325,20 @@ func (_m *Client) GetTxReceipt(hash common.Hash) (*eth.TxReceipt, error) {
 	return r0, r1
 }
 
+// ReplayTx provides a mock function with given fields: args, blockNumber
+func (_m *Client) ReplayTx(args eth.CallArgs, blockNumber *big.Int) error {
+	ret := _m.Called(args, blockNumber)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(eth.CallArgs, *big.Int) error); ok {
+		r0 = rf(args, blockNumber)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SendRawTx provides a mock function with given fields: hex
 func (_m *Client) SendRawTx(hex string) (common.Hash, error) {
 	ret := _m.Called(hex)
@@ -239,6 +362,20 @@ func (_m *Client) SendRawTx(hex string) (common.Hash, error) {
 	return r0, r1
 }
 
+// SimulateTx provides a mock function with given fields: args
+func (_m *Client) SimulateTx(args eth.CallArgs) error {
+	ret := _m.Called(args)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(eth.CallArgs) error); ok {
+		r0 = rf(args)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SubscribeToLogs provides a mock function with given fields: channel, q
 func (_m *Client) SubscribeToLogs(channel chan<- eth.Log, q ethereum.FilterQuery) (eth.Subscription, error) {
 	ret := _m.Called(channel, q)
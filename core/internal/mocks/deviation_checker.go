@@ -5,6 +5,7 @@ package mocks
 import context "context"
 import eth "chainlink/core/eth"
 import mock "github.com/stretchr/testify/mock"
+import models "chainlink/core/store/models"
 
 // DeviationChecker is an autogenerated mock type for the DeviationChecker type
 type DeviationChecker struct {
@@ -25,6 +26,20 @@ func (_m *DeviationChecker) Start(_a0 context.Context, _a1 eth.Client) error {
 	return r0
 }
 
+// Status provides a mock function with given fields:
+func (_m *DeviationChecker) Status() models.FluxMonitorStatus {
+	ret := _m.Called()
+
+	var r0 models.FluxMonitorStatus
+	if rf, ok := ret.Get(0).(func() models.FluxMonitorStatus); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(models.FluxMonitorStatus)
+	}
+
+	return r0
+}
+
 // Stop provides a mock function with given fields:
 func (_m *DeviationChecker) Stop() {
 	_m.Called()
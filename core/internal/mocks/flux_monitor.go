@@ -43,11 +43,32 @@ func (_m *FluxMonitor) Disconnect() {
 	_m.Called()
 }
 
+// JobStatus provides a mock function with given fields: _a0
+func (_m *FluxMonitor) JobStatus(_a0 *models.ID) []models.FluxMonitorStatus {
+	ret := _m.Called(_a0)
+
+	var r0 []models.FluxMonitorStatus
+	if rf, ok := ret.Get(0).(func(*models.ID) []models.FluxMonitorStatus); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.FluxMonitorStatus)
+		}
+	}
+
+	return r0
+}
+
 // OnNewHead provides a mock function with given fields: _a0
 func (_m *FluxMonitor) OnNewHead(_a0 *models.Head) {
 	_m.Called(_a0)
 }
 
+// OnReorg provides a mock function with given fields: ancestor, oldHeads, newHeads
+func (_m *FluxMonitor) OnReorg(ancestor *models.Head, oldHeads []models.Head, newHeads []models.Head) {
+	_m.Called(ancestor, oldHeads, newHeads)
+}
+
 // RemoveJob provides a mock function with given fields: _a0
 func (_m *FluxMonitor) RemoveJob(_a0 *models.ID) {
 	_m.Called(_a0)
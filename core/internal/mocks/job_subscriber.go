@@ -64,6 +64,11 @@ func (_m *JobSubscriber) OnNewHead(_a0 *models.Head) {
 	_m.Called(_a0)
 }
 
+// OnReorg provides a mock function with given fields: ancestor, oldHeads, newHeads
+func (_m *JobSubscriber) OnReorg(ancestor *models.Head, oldHeads []models.Head, newHeads []models.Head) {
+	_m.Called(ancestor, oldHeads, newHeads)
+}
+
 // RemoveJob provides a mock function with given fields: ID
 func (_m *JobSubscriber) RemoveJob(ID *models.ID) error {
 	ret := _m.Called(ID)
@@ -3,6 +3,7 @@
 package mocks
 
 import big "math/big"
+import eth "chainlink/core/eth"
 import mock "github.com/stretchr/testify/mock"
 import models "chainlink/core/store/models"
 
@@ -34,6 +35,34 @@ func (_m *RunManager) Cancel(runID *models.ID) (*models.JobRun, error) {
 	return r0, r1
 }
 
+// CancelRunForCancelledTx provides a mock function with given fields: tx
+func (_m *RunManager) CancelRunForCancelledTx(tx *models.Tx) error {
+	ret := _m.Called(tx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Tx) error); ok {
+		r0 = rf(tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CancelRunsForRemovedLog provides a mock function with given fields: initiator, log
+func (_m *RunManager) CancelRunsForRemovedLog(initiator models.Initiator, log eth.Log) error {
+	ret := _m.Called(initiator, log)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(models.Initiator, eth.Log) error); ok {
+		r0 = rf(initiator, log)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Create provides a mock function with given fields: jobSpecID, initiator, data, creationHeight, runRequest
 func (_m *RunManager) Create(jobSpecID *models.ID, initiator *models.Initiator, data *models.JSON, creationHeight *big.Int, runRequest *models.RunRequest) (*models.JobRun, error) {
 	ret := _m.Called(jobSpecID, initiator, data, creationHeight, runRequest)
@@ -80,6 +109,20 @@ func (_m *RunManager) CreateErrored(jobSpecID *models.ID, initiator models.Initi
 	return r0, r1
 }
 
+// InvalidateOrphanedRuns provides a mock function with given fields: orphanedHeads
+func (_m *RunManager) InvalidateOrphanedRuns(orphanedHeads []models.Head) error {
+	ret := _m.Called(orphanedHeads)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]models.Head) error); ok {
+		r0 = rf(orphanedHeads)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ResumeAllConfirming provides a mock function with given fields: currentBlockHeight
 func (_m *RunManager) ResumeAllConfirming(currentBlockHeight *big.Int) error {
 	ret := _m.Called(currentBlockHeight)
@@ -122,6 +165,20 @@ func (_m *RunManager) ResumeAllInProgress() error {
 	return r0
 }
 
+// ResumeAllRetrying provides a mock function with given fields:
+func (_m *RunManager) ResumeAllRetrying() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ResumePending provides a mock function with given fields: runID, input
 func (_m *RunManager) ResumePending(runID *models.ID, input models.BridgeRunResult) error {
 	ret := _m.Called(runID, input)
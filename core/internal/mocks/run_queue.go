@@ -10,11 +10,39 @@ type RunQueue struct {
 	mock.Mock
 }
 
+// QueueDepth provides a mock function with given fields:
+func (_m *RunQueue) QueueDepth() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
 // Run provides a mock function with given fields: _a0
 func (_m *RunQueue) Run(_a0 *models.JobRun) {
 	_m.Called(_a0)
 }
 
+// Saturated provides a mock function with given fields:
+func (_m *RunQueue) Saturated() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // Start provides a mock function with given fields:
 func (_m *RunQueue) Start() error {
 	ret := _m.Called()
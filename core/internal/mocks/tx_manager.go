@@ -49,6 +49,29 @@ func (_m *TxManager) BumpGasUntilSafe(hash common.Hash) (*eth.TxReceipt, store.A
 	return r0, r1, r2
 }
 
+// CancelTx provides a mock function with given fields: hash
+func (_m *TxManager) CancelTx(hash common.Hash) (*models.Tx, error) {
+	ret := _m.Called(hash)
+
+	var r0 *models.Tx
+	if rf, ok := ret.Get(0).(func(common.Hash) *models.Tx); ok {
+		r0 = rf(hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Tx)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Hash) error); ok {
+		r1 = rf(hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CheckAttempt provides a mock function with given fields: txAttempt, blockHeight
 func (_m *TxManager) CheckAttempt(txAttempt *models.TxAttempt, blockHeight uint64) (*eth.TxReceipt, store.AttemptState, error) {
 	ret := _m.Called(txAttempt, blockHeight)
@@ -174,13 +197,13 @@ func (_m *TxManager) CreateTxWithEth(from common.Address, to common.Address, val
 	return r0, r1
 }
 
-// CreateTxWithGas provides a mock function with given fields: surrogateID, to, data, gasPriceWei, gasLimit
-func (_m *TxManager) CreateTxWithGas(surrogateID null.String, to common.Address, data []byte, gasPriceWei *big.Int, gasLimit uint64) (*models.Tx, error) {
-	ret := _m.Called(surrogateID, to, data, gasPriceWei, gasLimit)
+// CreateTxWithGas provides a mock function with given fields: surrogateID, to, data, gasPriceWei, gasLimit, fromAddresses
+func (_m *TxManager) CreateTxWithGas(surrogateID null.String, to common.Address, data []byte, gasPriceWei *big.Int, gasLimit uint64, fromAddresses []common.Address) (*models.Tx, error) {
+	ret := _m.Called(surrogateID, to, data, gasPriceWei, gasLimit, fromAddresses)
 
 	var r0 *models.Tx
-	if rf, ok := ret.Get(0).(func(null.String, common.Address, []byte, *big.Int, uint64) *models.Tx); ok {
-		r0 = rf(surrogateID, to, data, gasPriceWei, gasLimit)
+	if rf, ok := ret.Get(0).(func(null.String, common.Address, []byte, *big.Int, uint64, []common.Address) *models.Tx); ok {
+		r0 = rf(surrogateID, to, data, gasPriceWei, gasLimit, fromAddresses)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.Tx)
@@ -188,8 +211,8 @@ func (_m *TxManager) CreateTxWithGas(surrogateID null.String, to common.Address,
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(null.String, common.Address, []byte, *big.Int, uint64) error); ok {
-		r1 = rf(surrogateID, to, data, gasPriceWei, gasLimit)
+	if rf, ok := ret.Get(1).(func(null.String, common.Address, []byte, *big.Int, uint64, []common.Address) error); ok {
+		r1 = rf(surrogateID, to, data, gasPriceWei, gasLimit, fromAddresses)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -202,6 +225,27 @@ func (_m *TxManager) Disconnect() {
 	_m.Called()
 }
 
+// EstimateGas provides a mock function with given fields: args
+func (_m *TxManager) EstimateGas(args eth.CallArgs) (uint64, error) {
+	ret := _m.Called(args)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(eth.CallArgs) uint64); ok {
+		r0 = rf(args)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(eth.CallArgs) error); ok {
+		r1 = rf(args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAggregatorPrice provides a mock function with given fields: address, precision
 func (_m *TxManager) GetAggregatorPrice(address common.Address, precision int32) (decimal.Decimal, error) {
 	ret := _m.Called(address, precision)
@@ -246,6 +290,43 @@ func (_m *TxManager) GetAggregatorRound(address common.Address) (*big.Int, error
 	return r0, r1
 }
 
+// GetAggregatorRoundState provides a mock function with given fields: address, oracle
+func (_m *TxManager) GetAggregatorRoundState(address common.Address, oracle common.Address) (eth.AggregatorRoundState, error) {
+	ret := _m.Called(address, oracle)
+
+	var r0 eth.AggregatorRoundState
+	if rf, ok := ret.Get(0).(func(common.Address, common.Address) eth.AggregatorRoundState); ok {
+		r0 = rf(address, oracle)
+	} else {
+		r0 = ret.Get(0).(eth.AggregatorRoundState)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, common.Address) error); ok {
+		r1 = rf(address, oracle)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAvailableAccount provides a mock function with given fields: from
+func (_m *TxManager) GetAvailableAccount(from common.Address) *store.ManagedAccount {
+	ret := _m.Called(from)
+
+	var r0 *store.ManagedAccount
+	if rf, ok := ret.Get(0).(func(common.Address) *store.ManagedAccount); ok {
+		r0 = rf(from)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*store.ManagedAccount)
+		}
+	}
+
+	return r0
+}
+
 // GetBlockByNumber provides a mock function with given fields: hex
 func (_m *TxManager) GetBlockByNumber(hex string) (eth.BlockHeader, error) {
 	ret := _m.Called(hex)
@@ -267,6 +348,27 @@ func (_m *TxManager) GetBlockByNumber(hex string) (eth.BlockHeader, error) {
 	return r0, r1
 }
 
+// GetBlockWithTransactions provides a mock function with given fields: hex
+func (_m *TxManager) GetBlockWithTransactions(hex string) (eth.Block, error) {
+	ret := _m.Called(hex)
+
+	var r0 eth.Block
+	if rf, ok := ret.Get(0).(func(string) eth.Block); ok {
+		r0 = rf(hex)
+	} else {
+		r0 = ret.Get(0).(eth.Block)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(hex)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetChainID provides a mock function with given fields:
 func (_m *TxManager) GetChainID() (*big.Int, error) {
 	ret := _m.Called()
@@ -336,6 +438,29 @@ func (_m *TxManager) GetEthBalance(address common.Address) (*assets.Eth, error)
 	return r0, r1
 }
 
+// GetEthBalances provides a mock function with given fields: addresses
+func (_m *TxManager) GetEthBalances(addresses []common.Address) ([]*assets.Eth, error) {
+	ret := _m.Called(addresses)
+
+	var r0 []*assets.Eth
+	if rf, ok := ret.Get(0).(func([]common.Address) []*assets.Eth); ok {
+		r0 = rf(addresses)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*assets.Eth)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]common.Address) error); ok {
+		r1 = rf(addresses)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetLINKBalance provides a mock function with given fields: address
 func (_m *TxManager) GetLINKBalance(address common.Address) (*assets.Link, error) {
 	ret := _m.Called(address)
@@ -382,6 +507,29 @@ func (_m *TxManager) GetLogs(q ethereum.FilterQuery) ([]eth.Log, error) {
 	return r0, r1
 }
 
+// GetLogsBatch provides a mock function with given fields: qs
+func (_m *TxManager) GetLogsBatch(qs []ethereum.FilterQuery) ([][]eth.Log, error) {
+	ret := _m.Called(qs)
+
+	var r0 [][]eth.Log
+	if rf, ok := ret.Get(0).(func([]ethereum.FilterQuery) [][]eth.Log); ok {
+		r0 = rf(qs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([][]eth.Log)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]ethereum.FilterQuery) error); ok {
+		r1 = rf(qs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetNonce provides a mock function with given fields: address
 func (_m *TxManager) GetNonce(address common.Address) (uint64, error) {
 	ret := _m.Called(address)
@@ -426,6 +574,29 @@ func (_m *TxManager) GetTxReceipt(hash common.Hash) (*eth.TxReceipt, error) {
 	return r0, r1
 }
 
+// NextAccount provides a mock function with given fields: fromAddresses
+func (_m *TxManager) NextAccount(fromAddresses []common.Address) (*store.ManagedAccount, error) {
+	ret := _m.Called(fromAddresses)
+
+	var r0 *store.ManagedAccount
+	if rf, ok := ret.Get(0).(func([]common.Address) *store.ManagedAccount); ok {
+		r0 = rf(fromAddresses)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*store.ManagedAccount)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]common.Address) error); ok {
+		r1 = rf(fromAddresses)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NextActiveAccount provides a mock function with given fields:
 func (_m *TxManager) NextActiveAccount() *store.ManagedAccount {
 	ret := _m.Called()
@@ -447,11 +618,30 @@ func (_m *TxManager) OnNewHead(_a0 *models.Head) {
 	_m.Called(_a0)
 }
 
+// OnReorg provides a mock function with given fields: ancestor, oldHeads, newHeads
+func (_m *TxManager) OnReorg(ancestor *models.Head, oldHeads []models.Head, newHeads []models.Head) {
+	_m.Called(ancestor, oldHeads, newHeads)
+}
+
 // Register provides a mock function with given fields: _a0
 func (_m *TxManager) Register(_a0 []accounts.Account) {
 	_m.Called(_a0)
 }
 
+// ReplayTx provides a mock function with given fields: args, blockNumber
+func (_m *TxManager) ReplayTx(args eth.CallArgs, blockNumber *big.Int) error {
+	ret := _m.Called(args, blockNumber)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(eth.CallArgs, *big.Int) error); ok {
+		r0 = rf(args, blockNumber)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SendRawTx provides a mock function with given fields: hex
 func (_m *TxManager) SendRawTx(hex string) (common.Hash, error) {
 	ret := _m.Called(hex)
@@ -475,6 +665,20 @@ func (_m *TxManager) SendRawTx(hex string) (common.Hash, error) {
 	return r0, r1
 }
 
+// SimulateTx provides a mock function with given fields: args
+func (_m *TxManager) SimulateTx(args eth.CallArgs) error {
+	ret := _m.Called(args)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(eth.CallArgs) error); ok {
+		r0 = rf(args)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SubscribeToLogs provides a mock function with given fields: channel, q
 func (_m *TxManager) SubscribeToLogs(channel chan<- eth.Log, q ethereum.FilterQuery) (eth.Subscription, error) {
 	ret := _m.Called(channel, q)
@@ -24,6 +24,7 @@ type Application interface {
 	Start() error
 	Stop() error
 	GetStore() *store.Store
+	GetFluxMonitor() FluxMonitor
 	WakeSessionReaper()
 	AddJob(job models.JobSpec) error
 	ArchiveJob(*models.ID) error
@@ -46,6 +47,7 @@ type ChainlinkApplication struct {
 	Store                    *store.Store
 	SessionReaper            SleeperTask
 	pendingConnectionResumer *pendingConnectionResumer
+	retryResumer             *retryResumer
 	shutdownOnce             sync.Once
 }
 
@@ -58,10 +60,11 @@ func NewApplication(config *orm.Config, onConnectCallbacks ...func(Application))
 	config.SetRuntimeStore(store.ORM)
 
 	runExecutor := NewRunExecutor(store)
-	runQueue := NewRunQueue(runExecutor)
+	runQueue := NewRunQueue(runExecutor, config)
 	runManager := NewRunManager(runQueue, config, store.ORM, store.TxManager, store.Clock)
-	jobSubscriber := NewJobSubscriber(store, runManager)
-	fluxMonitor := NewFluxMonitor(store, runManager)
+	logBroadcaster := NewLogBroadcaster(store.TxManager)
+	jobSubscriber := NewJobSubscriber(store, runManager, logBroadcaster)
+	fluxMonitor := NewFluxMonitor(store, runManager, logBroadcaster)
 
 	pendingConnectionResumer := newPendingConnectionResumer(runManager)
 
@@ -75,10 +78,12 @@ func NewApplication(config *orm.Config, onConnectCallbacks ...func(Application))
 		SessionReaper:            NewStoreReaper(store),
 		Exiter:                   os.Exit,
 		pendingConnectionResumer: pendingConnectionResumer,
+		retryResumer:             newRetryResumer(runManager),
 	}
 
 	headTrackables := []strpkg.HeadTrackable{
 		store.TxManager,
+		NewBalanceMonitor(store),
 		jobSubscriber,
 		pendingConnectionResumer,
 		fluxMonitor,
@@ -116,6 +121,7 @@ func (app *ChainlinkApplication) Start() error {
 		app.Store.Start(),
 		app.RunQueue.Start(),
 		app.RunManager.ResumeAllInProgress(),
+		app.retryResumer.Start(),
 		app.FluxMonitor.Start(),
 
 		// HeadTracker deliberately started after
@@ -141,6 +147,7 @@ func (app *ChainlinkApplication) Stop() error {
 		merr = multierr.Append(merr, app.HeadTracker.Stop())
 		app.JobSubscriber.Stop()
 		app.FluxMonitor.Stop()
+		app.retryResumer.Stop()
 		app.RunQueue.Stop()
 		merr = multierr.Append(merr, app.SessionReaper.Stop())
 		merr = multierr.Append(merr, app.Store.Close())
@@ -153,6 +160,11 @@ func (app *ChainlinkApplication) GetStore() *store.Store {
 	return app.Store
 }
 
+// GetFluxMonitor returns the flux monitor for the ChainlinkApplication.
+func (app *ChainlinkApplication) GetFluxMonitor() FluxMonitor {
+	return app.FluxMonitor
+}
+
 // WakeSessionReaper wakes up the reaper to do its reaping.
 func (app *ChainlinkApplication) WakeSessionReaper() {
 	app.SessionReaper.WakeUp()
@@ -220,5 +232,6 @@ func (p *pendingConnectionResumer) Connect(head *models.Head) error {
 	return p.runManager.ResumeAllConnecting()
 }
 
-func (p *pendingConnectionResumer) Disconnect()            {}
-func (p *pendingConnectionResumer) OnNewHead(*models.Head) {}
+func (p *pendingConnectionResumer) Disconnect()                                        {}
+func (p *pendingConnectionResumer) OnNewHead(*models.Head)                             {}
+func (p *pendingConnectionResumer) OnReorg(*models.Head, []models.Head, []models.Head) {}
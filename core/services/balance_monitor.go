@@ -0,0 +1,200 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"chainlink/core/assets"
+	"chainlink/core/logger"
+	strpkg "chainlink/core/store"
+	"chainlink/core/store/models"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	numberEthBalance = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "eth_balance",
+		Help: "The ETH balance of each of the node's keys",
+	},
+		[]string{"address"},
+	)
+	numberLinkBalance = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "link_balance",
+		Help: "The LINK balance of each of the node's keys",
+	},
+		[]string{"address"},
+	)
+)
+
+// balanceWebhookTimeout is how long the balance monitor waits for the
+// BALANCE_WEBHOOK_URL to respond.
+const balanceWebhookTimeout = 10 * time.Second
+
+// BalanceMonitor checks the ETH and LINK balances of the node's keys on every
+// new head. It exports them as Prometheus gauges, records the ETH balance on
+// the TxManager's accounts so that transactions a key cannot afford are
+// refused, and warns when the ETH balance of a key falls below
+// ETH_BALANCE_THRESHOLD.
+type BalanceMonitor struct {
+	store    *strpkg.Store
+	client   *http.Client
+	checking int32
+
+	lowMutex sync.Mutex
+	low      map[common.Address]bool
+}
+
+// NewBalanceMonitor returns a BalanceMonitor for the keys of the given store.
+func NewBalanceMonitor(store *strpkg.Store) *BalanceMonitor {
+	return &BalanceMonitor{
+		store:  store,
+		client: &http.Client{Timeout: balanceWebhookTimeout},
+		low:    make(map[common.Address]bool),
+	}
+}
+
+// Connect checks the balances of the node's keys as soon as the node
+// connects.
+func (bm *BalanceMonitor) Connect(*models.Head) error {
+	bm.checkBalancesInBackground()
+	return nil
+}
+
+// Disconnect is a no-op.
+func (bm *BalanceMonitor) Disconnect() {}
+
+// OnNewHead checks the balances of the node's keys.
+func (bm *BalanceMonitor) OnNewHead(*models.Head) {
+	bm.checkBalancesInBackground()
+}
+
+// OnReorg is a no-op, the balances are checked on the new head that follows.
+func (bm *BalanceMonitor) OnReorg(*models.Head, []models.Head, []models.Head) {}
+
+// checkBalancesInBackground checks the balances without holding up the head
+// tracker, skipping the check if the previous one is still in progress.
+func (bm *BalanceMonitor) checkBalancesInBackground() {
+	if !bm.store.Config.BalanceMonitorEnabled() {
+		return
+	}
+	if !atomic.CompareAndSwapInt32(&bm.checking, 0, 1) {
+		return
+	}
+	go func() {
+		defer atomic.StoreInt32(&bm.checking, 0)
+		bm.checkBalances()
+	}()
+}
+
+func (bm *BalanceMonitor) checkBalances() {
+	var addresses []common.Address
+	for _, account := range bm.store.KeyStore.GetAccounts() {
+		addresses = append(addresses, account.Address)
+	}
+
+	balances, err := bm.store.TxManager.GetEthBalances(addresses)
+	if err != nil {
+		logger.Warnw("Unable to check ETH balances", "error", err)
+	} else {
+		for i, address := range addresses {
+			bm.checkEthBalance(address, balances[i])
+		}
+	}
+
+	for _, address := range addresses {
+		bm.checkLinkBalance(address)
+	}
+}
+
+func (bm *BalanceMonitor) checkEthBalance(address common.Address, balance *assets.Eth) {
+	numberEthBalance.WithLabelValues(address.Hex()).Set(toFloat(balance.ToInt()))
+	if ma := bm.store.TxManager.GetAvailableAccount(address); ma != nil {
+		ma.SetEthBalance(balance)
+	}
+
+	threshold := bm.store.Config.EthBalanceThreshold()
+	low := balance.Cmp(threshold) < 0
+
+	bm.lowMutex.Lock()
+	wasLow := bm.low[address]
+	bm.low[address] = low
+	bm.lowMutex.Unlock()
+
+	if low && !wasLow {
+		logger.Warnw(
+			fmt.Sprintf("ETH balance of %s has fallen below %s ETH, top it up to keep sending transactions", address.Hex(), threshold),
+			"address", address.Hex(),
+			"ethBalance", balance,
+			"threshold", threshold,
+		)
+		bm.notify(address, balance, threshold)
+	} else if !low && wasLow {
+		logger.Infow(
+			fmt.Sprintf("ETH balance of %s is back above %s ETH", address.Hex(), threshold),
+			"address", address.Hex(),
+			"ethBalance", balance,
+			"threshold", threshold,
+		)
+	}
+}
+
+func (bm *BalanceMonitor) checkLinkBalance(address common.Address) {
+	balance, err := bm.store.TxManager.GetLINKBalance(address)
+	if err != nil {
+		logger.Warnw("Unable to check LINK balance", "address", address.Hex(), "error", err)
+		return
+	}
+	numberLinkBalance.WithLabelValues(address.Hex()).Set(toFloat(balance.ToInt()))
+}
+
+// balanceWebhookPayload is the body POSTed to BALANCE_WEBHOOK_URL when the
+// ETH balance of a key falls below ETH_BALANCE_THRESHOLD.
+type balanceWebhookPayload struct {
+	Address    string      `json:"address"`
+	EthBalance *assets.Eth `json:"ethBalance"`
+	Threshold  *assets.Eth `json:"threshold"`
+}
+
+func (bm *BalanceMonitor) notify(address common.Address, balance, threshold *assets.Eth) {
+	url := bm.store.Config.BalanceWebhookURL()
+	if url == nil {
+		return
+	}
+
+	body, err := json.Marshal(balanceWebhookPayload{
+		Address:    address.Hex(),
+		EthBalance: balance,
+		Threshold:  threshold,
+	})
+	if err != nil {
+		logger.Errorw("Unable to encode low balance notification", "error", err)
+		return
+	}
+
+	response, err := bm.client.Post(url.String(), "application/json", bytes.NewReader(body))
+	if err != nil {
+		logger.Warnw("Unable to send low balance notification", "address", address.Hex(), "error", err)
+		return
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= 400 {
+		logger.Warnw("Low balance notification was rejected", "address", address.Hex(), "status", response.StatusCode)
+	}
+}
+
+// toFloat converts an amount in the currency's smallest unit, which has 18
+// decimals for both ETH and LINK, to a float for the Prometheus gauges.
+func toFloat(amount *big.Int) float64 {
+	f, _ := new(big.Float).Quo(new(big.Float).SetInt(amount), big.NewFloat(1e18)).Float64()
+	return f
+}
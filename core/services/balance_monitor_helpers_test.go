@@ -0,0 +1,5 @@
+package services
+
+func (bm *BalanceMonitor) ExportedCheckBalances() {
+	bm.checkBalances()
+}
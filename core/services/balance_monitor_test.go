@@ -0,0 +1,68 @@
+package services_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"chainlink/core/assets"
+	"chainlink/core/internal/cltest"
+	"chainlink/core/internal/mocks"
+	"chainlink/core/services"
+	strpkg "chainlink/core/store"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBalanceMonitor_CheckBalances(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	account, err := store.KeyStore.NewAccount(cltest.Password)
+	require.NoError(t, err)
+
+	notifications := make(chan map[string]interface{}, 3)
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if assert.NoError(t, json.NewDecoder(r.Body).Decode(&body)) {
+			notifications <- body
+		}
+	}))
+	defer webhook.Close()
+
+	store.Config.Set("ETH_BALANCE_THRESHOLD", "10")
+	store.Config.Set("BALANCE_WEBHOOK_URL", webhook.URL)
+
+	ma := strpkg.NewManagedAccount(account, 0)
+	txManager := new(mocks.TxManager)
+	addresses := []common.Address{account.Address}
+	txManager.On("GetEthBalances", addresses).Return([]*assets.Eth{assets.NewEth(5)}, nil).Twice()
+	txManager.On("GetEthBalances", addresses).Return([]*assets.Eth{assets.NewEth(20)}, nil).Once()
+	txManager.On("GetLINKBalance", account.Address).Return(assets.NewLink(3), nil)
+	txManager.On("GetAvailableAccount", account.Address).Return(ma)
+	store.TxManager = txManager
+
+	bm := services.NewBalanceMonitor(store)
+
+	bm.ExportedCheckBalances()
+	assert.Equal(t, assets.NewEth(5), ma.EthBalance())
+	require.Len(t, notifications, 1)
+	notification := <-notifications
+	assert.Equal(t, account.Address.Hex(), notification["address"])
+	assert.Equal(t, "5", notification["ethBalance"])
+	assert.Equal(t, "10", notification["threshold"])
+
+	bm.ExportedCheckBalances()
+	assert.Len(t, notifications, 0, "should only notify when the balance first falls below the threshold")
+
+	bm.ExportedCheckBalances()
+	assert.Equal(t, assets.NewEth(20), ma.EthBalance())
+	assert.Len(t, notifications, 0)
+
+	txManager.AssertExpectations(t)
+}
@@ -9,15 +9,26 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/jpillora/backoff"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/shopspring/decimal"
 	"go.uber.org/multierr"
 )
 
+var (
+	numberSubmissionsSkipped = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "flux_monitor_submissions_skipped",
+		Help: "The number of flux monitor submissions skipped because the oracle was not eligible to answer the round, by reason",
+	}, []string{"reason"})
+)
+
 //go:generate mockery -name FluxMonitor -output ../internal/mocks/ -case=underscore
 
 // FluxMonitor is the interface encapsulating all functionality
@@ -26,6 +37,7 @@ type FluxMonitor interface {
 	store.HeadTrackable // (Dis)Connect methods handle initial boot and intermittent connectivity.
 	AddJob(models.JobSpec) error
 	RemoveJob(*models.ID)
+	JobStatus(*models.ID) []models.FluxMonitorStatus
 	Start() error
 	Stop()
 }
@@ -33,9 +45,11 @@ type FluxMonitor interface {
 type concreteFluxMonitor struct {
 	store          *store.Store
 	runManager     RunManager
+	logBroadcaster *LogBroadcaster
 	checkerFactory DeviationCheckerFactory
 	adds           chan addEntry
 	removes        chan *models.ID
+	statuses       chan statusEntry
 	connect        chan *models.Head
 	disconnect     chan struct{}
 	ctx            context.Context
@@ -47,13 +61,20 @@ type addEntry struct {
 	rchan chan error
 }
 
+type statusEntry struct {
+	jobID *models.ID
+	rchan chan []models.FluxMonitorStatus
+}
+
 // NewFluxMonitor creates a service that manages a collection of DeviationCheckers,
-// one per initiator of type InitiatorFluxMonitor for added jobs.
-func NewFluxMonitor(store *store.Store, runManager RunManager) FluxMonitor {
+// one per initiator of type InitiatorFluxMonitor for added jobs. The checkers
+// subscribe to new rounds through the log broadcaster.
+func NewFluxMonitor(store *store.Store, runManager RunManager, logBroadcaster *LogBroadcaster) FluxMonitor {
 	return &concreteFluxMonitor{
 		store:          store,
 		runManager:     runManager,
-		checkerFactory: pollingDeviationCheckerFactory{},
+		logBroadcaster: logBroadcaster,
+		checkerFactory: pollingDeviationCheckerFactory{store: store},
 	}
 }
 
@@ -61,6 +82,7 @@ func (fm *concreteFluxMonitor) Start() error {
 	fm.ctx, fm.cancel = context.WithCancel(context.Background())
 	fm.adds = make(chan addEntry)
 	fm.removes = make(chan *models.ID)
+	fm.statuses = make(chan statusEntry)
 	fm.connect = make(chan *models.Head)
 	fm.disconnect = make(chan struct{})
 
@@ -105,7 +127,7 @@ func (fm *concreteFluxMonitor) actionConsumer(ctx context.Context) {
 		case <-fm.connect:
 			// every connection, create a new ctx for canceling on disconnect.
 			connectionCtx, cancelConnection = context.WithCancel(ctx)
-			connectCheckers(connectionCtx, jobMap, fm.store.TxManager)
+			connectCheckers(connectionCtx, jobMap, fm.client())
 			connected = true
 		case <-fm.disconnect:
 			cancelConnection()
@@ -117,6 +139,12 @@ func (fm *concreteFluxMonitor) actionConsumer(ctx context.Context) {
 				checker.Stop()
 			}
 			delete(jobMap, jobID.String())
+		case entry := <-fm.statuses:
+			var statuses []models.FluxMonitorStatus
+			for _, checker := range jobMap[entry.jobID.String()] {
+				statuses = append(statuses, checker.Status())
+			}
+			entry.rchan <- statuses
 		}
 	}
 }
@@ -136,6 +164,9 @@ func (fm *concreteFluxMonitor) Stop() {
 // OnNewHead is a noop.
 func (fm *concreteFluxMonitor) OnNewHead(*models.Head) {}
 
+// OnReorg is a noop.
+func (fm *concreteFluxMonitor) OnReorg(*models.Head, []models.Head, []models.Head) {}
+
 // AddJob created a DeviationChecker for any job initiators of type
 // InitiatorFluxMonitor.
 func (fm *concreteFluxMonitor) AddJob(job models.JobSpec) error {
@@ -170,7 +201,7 @@ func (fm *concreteFluxMonitor) addAction(ctx context.Context, connected bool, jo
 			return errors.Wrap(err, "factory unable to create checker")
 		}
 		if connected {
-			err := connectSingleChecker(ctx, checker, fm.store.TxManager)
+			err := connectSingleChecker(ctx, checker, fm.client())
 			if err != nil {
 				return errors.Wrap(err, "unable to connect checker")
 			}
@@ -184,6 +215,12 @@ func (fm *concreteFluxMonitor) addAction(ctx context.Context, connected bool, jo
 	return nil
 }
 
+// client returns the ethereum client of the checkers, which subscribe to logs
+// through the log broadcaster.
+func (fm *concreteFluxMonitor) client() eth.Client {
+	return logBroadcastingClient{Client: fm.store.TxManager, logBroadcaster: fm.logBroadcaster}
+}
+
 func connectSingleChecker(ctx context.Context, checker DeviationChecker, client eth.Client) error {
 	return checker.Start(ctx, client)
 }
@@ -194,6 +231,23 @@ func (fm *concreteFluxMonitor) RemoveJob(ID *models.ID) {
 	fm.removes <- ID
 }
 
+// JobStatus returns the status of the checker for each Flux Monitor initiator
+// belonging to the passed job ID, or none if the job has not been added or
+// the flux monitor is not running.
+func (fm *concreteFluxMonitor) JobStatus(ID *models.ID) []models.FluxMonitorStatus {
+	if fm.ctx == nil {
+		return nil
+	}
+
+	rchan := make(chan []models.FluxMonitorStatus, 1)
+	select {
+	case fm.statuses <- statusEntry{ID, rchan}:
+		return <-rchan
+	case <-fm.ctx.Done():
+		return nil
+	}
+}
+
 //go:generate mockery -name DeviationCheckerFactory -output ../internal/mocks/ -case=underscore
 
 // DeviationCheckerFactory holds the New method needed to create a new instance
@@ -202,19 +256,43 @@ type DeviationCheckerFactory interface {
 	New(models.Initiator, RunManager) (DeviationChecker, error)
 }
 
-type pollingDeviationCheckerFactory struct{}
+type pollingDeviationCheckerFactory struct {
+	store *store.Store
+}
 
 func (f pollingDeviationCheckerFactory) New(initr models.Initiator, runManager RunManager) (DeviationChecker, error) {
+	httpTimeout := defaultHTTPTimeout
+	if initr.InitiatorParams.HTTPTimeout > 0 {
+		httpTimeout = initr.InitiatorParams.HTTPTimeout.Duration()
+	}
+	pollInterval := defaultPollInterval
+	if initr.InitiatorParams.PollInterval > 0 {
+		pollInterval = initr.InitiatorParams.PollInterval.Duration()
+	}
+
 	fetcher, err := newMedianFetcherFromURLs(
-		defaultHTTPTimeout,
+		httpTimeout,
 		initr.InitiatorParams.RequestData.String(),
+		initr.InitiatorParams.MinimumResponses,
 		initr.InitiatorParams.Feeds...)
 
 	if err != nil {
 		return nil, err
 	}
 
-	return NewPollingDeviationChecker(initr, runManager, fetcher, 1*time.Minute)
+	checker, err := NewPollingDeviationChecker(initr, runManager, fetcher, pollInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(initr.FromAddresses) == 0 {
+		account, err := f.store.KeyStore.GetFirstAccount()
+		if err != nil {
+			return nil, err
+		}
+		checker.oracle = account.Address
+	}
+	return checker, nil
 }
 
 //go:generate mockery -name DeviationChecker -output ../internal/mocks/ -case=underscore
@@ -224,26 +302,55 @@ func (f pollingDeviationCheckerFactory) New(initr models.Initiator, runManager R
 type DeviationChecker interface {
 	Start(context.Context, eth.Client) error
 	Stop()
+	Status() models.FluxMonitorStatus
 }
 
-// PollingDeviationChecker polls external price adapters via HTTP to check for price swings.
+// PollingDeviationChecker polls external price adapters via HTTP to check for
+// price swings. If an idle threshold is set, it also starts a new round once
+// no answer has been submitted for that long, even without a price swing.
+//
+// A price swing is a change by at least the relative threshold, a percentage
+// of the current price, and/or by at least the absolute threshold, depending
+// on which are set and on the threshold mode. Prices outside of the answer
+// bounds are never submitted.
 type PollingDeviationChecker struct {
-	initr        models.Initiator
-	address      common.Address
-	requestData  models.JSON
-	threshold    float64
-	precision    int32
-	runManager   RunManager
-	currentPrice decimal.Decimal
-	currentRound *big.Int
-	fetcher      Fetcher
-	delay        time.Duration
-	cancel       context.CancelFunc
-	newRounds    chan eth.Log
-}
-
-// defaultHTTPTimeout is the timeout used by the price adapter fetcher for outgoing HTTP requests.
-const defaultHTTPTimeout = 5 * time.Second
+	initr             models.Initiator
+	address           common.Address
+	requestData       models.JSON
+	threshold         float64
+	absoluteThreshold *decimal.Decimal
+	thresholdMode     models.ThresholdMode
+	minAnswer         *decimal.Decimal
+	maxAnswer         *decimal.Decimal
+	precision         int32
+	runManager        RunManager
+	client            eth.Client
+	oracle            common.Address
+	currentPrice      decimal.Decimal
+	currentRound      *big.Int
+	fetcher           Fetcher
+	delay             time.Duration
+	idleThreshold     time.Duration
+	lastSubmittedAt   time.Time
+	heartbeatRetryAt  time.Time
+	heartbeatBackoff  backoff.Backoff
+	cancel            context.CancelFunc
+	newRounds         chan eth.Log
+	statusMu          sync.Mutex
+	status            models.FluxMonitorStatus
+}
+
+const (
+	// defaultHTTPTimeout is the timeout used by the price adapter fetcher for
+	// outgoing HTTP requests.
+	defaultHTTPTimeout = 5 * time.Second
+	// defaultPollInterval is how often the price adapters are polled.
+	defaultPollInterval = 1 * time.Minute
+	// minHeartbeatRetryDelay is how long a heartbeat which did not submit an
+	// answer waits before being retried. The delay doubles on every retry, up
+	// to the idle threshold.
+	minHeartbeatRetryDelay = 1 * time.Second
+)
 
 // NewPollingDeviationChecker returns a new instance of PollingDeviationChecker.
 func NewPollingDeviationChecker(
@@ -252,18 +359,40 @@ func NewPollingDeviationChecker(
 	fetcher Fetcher,
 	delay time.Duration,
 ) (*PollingDeviationChecker, error) {
+	// The oracle submits from the initiator's first from address, if any,
+	// which is also the key the aggregator is asked about when checking
+	// eligibility to answer a round.
+	var oracle common.Address
+	if len(initr.FromAddresses) > 0 {
+		oracle = initr.FromAddresses[0]
+	}
+	heartbeatBackoff := backoff.Backoff{Min: minHeartbeatRetryDelay, Max: minHeartbeatRetryDelay}
+	if idleThreshold := initr.InitiatorParams.IdleThreshold.Duration(); idleThreshold > heartbeatBackoff.Max {
+		heartbeatBackoff.Max = idleThreshold
+	}
 	return &PollingDeviationChecker{
-		initr:        initr,
-		address:      initr.InitiatorParams.Address,
-		requestData:  initr.InitiatorParams.RequestData,
-		threshold:    float64(initr.InitiatorParams.Threshold),
-		precision:    initr.InitiatorParams.Precision,
-		runManager:   runManager,
-		currentPrice: decimal.NewFromInt(0),
-		currentRound: big.NewInt(0),
-		fetcher:      fetcher,
-		delay:        delay,
-		newRounds:    make(chan eth.Log),
+		initr:             initr,
+		address:           initr.InitiatorParams.Address,
+		requestData:       initr.InitiatorParams.RequestData,
+		threshold:         float64(initr.InitiatorParams.Threshold),
+		absoluteThreshold: initr.InitiatorParams.AbsoluteThreshold,
+		thresholdMode:     initr.InitiatorParams.ThresholdMode,
+		minAnswer:         initr.InitiatorParams.MinAnswer,
+		maxAnswer:         initr.InitiatorParams.MaxAnswer,
+		precision:         initr.InitiatorParams.Precision,
+		oracle:            oracle,
+		runManager:        runManager,
+		currentPrice:      decimal.NewFromInt(0),
+		currentRound:      big.NewInt(0),
+		fetcher:           fetcher,
+		delay:             delay,
+		idleThreshold:     initr.InitiatorParams.IdleThreshold.Duration(),
+		heartbeatBackoff:  heartbeatBackoff,
+		newRounds:         make(chan eth.Log),
+		status: models.FluxMonitorStatus{
+			InitiatorID: initr.ID,
+			Address:     initr.InitiatorParams.Address,
+		},
 	}, nil
 }
 
@@ -273,21 +402,29 @@ func (p *PollingDeviationChecker) Start(ctx context.Context, client eth.Client)
 	logger.Debugw("Starting checker for job",
 		"job", p.initr.JobSpecID.String(),
 		"initr", p.initr.ID)
+	p.client = client
 	err := p.fetchAggregatorData(client)
 	if err != nil {
+		p.recordResult(err)
 		return err
 	}
 
 	roundSubscription, err := p.subscribeToNewRounds(client)
 	if err != nil {
+		p.recordResult(err)
 		return err
 	}
 
+	p.lastSubmittedAt = time.Now()
 	err = p.poll()
+	p.recordResult(err)
 	if err != nil {
 		return err
 	}
 
+	p.updateStatus(func(status *models.FluxMonitorStatus) {
+		status.Connected = true
+	})
 	ctx, p.cancel = context.WithCancel(ctx)
 	go p.consume(ctx, roundSubscription)
 	return nil
@@ -302,9 +439,21 @@ func (p *PollingDeviationChecker) consume(ctx context.Context, roundSubscription
 		case err := <-roundSubscription.Err():
 			logger.Error(errors.Wrap(err, "checker lost subscription to NewRound log events"))
 		case log := <-p.newRounds:
-			logger.ErrorIf(p.respondToNewRound(log), "checker unable to respond to new round")
+			err := p.respondToNewRound(log)
+			logger.ErrorIf(err, "checker unable to respond to new round")
+			p.recordResult(err)
 		case <-time.After(p.delay):
-			logger.ErrorIf(p.poll(), "checker unable to poll")
+			err := p.poll()
+			logger.ErrorIf(err, "checker unable to poll")
+			p.recordResult(err)
+		case <-p.idleTimeout():
+			lastSubmittedAt := p.lastSubmittedAt
+			err := p.heartbeat()
+			logger.ErrorIf(err, "checker unable to submit heartbeat")
+			p.recordResult(err)
+			if !p.lastSubmittedAt.After(lastSubmittedAt) {
+				p.heartbeatRetryAt = time.Now().Add(p.heartbeatBackoff.Duration())
+			}
 		}
 	}
 }
@@ -314,6 +463,39 @@ func (p *PollingDeviationChecker) Stop() {
 	if p.cancel != nil {
 		p.cancel()
 	}
+	p.updateStatus(func(status *models.FluxMonitorStatus) {
+		status.Connected = false
+	})
+}
+
+// Status returns a snapshot of the checker's state, as of its last action.
+// It is safe to call from any goroutine.
+func (p *PollingDeviationChecker) Status() models.FluxMonitorStatus {
+	p.statusMu.Lock()
+	defer p.statusMu.Unlock()
+	return p.status
+}
+
+func (p *PollingDeviationChecker) updateStatus(update func(*models.FluxMonitorStatus)) {
+	p.statusMu.Lock()
+	defer p.statusMu.Unlock()
+	update(&p.status)
+}
+
+// recordResult records the on-chain price and round, and the error if any,
+// after an action of the checker.
+// Only invoked by the CSP consumer on the single goroutine for thread safety.
+func (p *PollingDeviationChecker) recordResult(err error) {
+	price, round := p.currentPrice, utils.NewBig(new(big.Int).Set(p.currentRound))
+	p.updateStatus(func(status *models.FluxMonitorStatus) {
+		status.CurrentPrice = price
+		status.CurrentRound = round
+		if err != nil {
+			now := time.Now()
+			status.LastError = err.Error()
+			status.LastErrorAt = &now
+		}
+	})
 }
 
 // fetchAggregatorData retrieves the price that's on-chain, with which we check
@@ -379,12 +561,22 @@ func (p *PollingDeviationChecker) respondToNewRound(log eth.Log) error {
 		"jobID", p.initr.JobSpecID,
 	)
 	p.currentRound = requestedRound
-	nextPrice, err := p.fetchPrices()
+
+	state, err := p.roundState()
+	if err != nil {
+		return err
+	}
+	if reason := ineligibility(state, requestedRound); reason != "" {
+		p.skipSubmission(requestedRound, reason)
+		return nil
+	}
+
+	nextPrice, feeds, err := p.fetchPrices()
 	if err != nil {
 		return err
 	}
 
-	err = p.createJobRun(nextPrice, requestedRound)
+	err = p.createJobRun(nextPrice, requestedRound, feeds)
 	if err != nil {
 		return err
 	}
@@ -397,22 +589,49 @@ func (p *PollingDeviationChecker) respondToNewRound(log eth.Log) error {
 // is not met, or triggering a new job run if deviation is met.
 // Only invoked by the CSP consumer on the single goroutine for thread safety.
 func (p *PollingDeviationChecker) poll() error {
-	nextPrice, err := p.fetchPrices()
+	nextPrice, feeds, err := p.fetchPrices()
 	if err != nil {
 		return err
 	}
 
-	if !OutsideDeviation(p.currentPrice, nextPrice, p.threshold) {
+	if !p.outsideThresholds(nextPrice) {
 		return nil // early exit since deviation criteria not met.
 	}
 
-	nextRound := new(big.Int).Add(p.currentRound, big.NewInt(1)) // start new round
-	logger.Infow("Detected change outside threshold, starting new round",
+	return p.startNewRound("Detected change outside threshold, starting new round", nextPrice, feeds)
+}
+
+// heartbeat starts a new round with the current price, regardless of its
+// deviation, to keep the answer fresh when the idle threshold has passed.
+// Only invoked by the CSP consumer on the single goroutine for thread safety.
+func (p *PollingDeviationChecker) heartbeat() error {
+	nextPrice, feeds, err := p.fetchPrices()
+	if err != nil {
+		return err
+	}
+
+	return p.startNewRound("No answer submitted within idle threshold, starting new round", nextPrice, feeds)
+}
+
+// startNewRound submits the next price to the round currently open for
+// answers, unless this oracle is not eligible to answer it.
+func (p *PollingDeviationChecker) startNewRound(msg string, nextPrice decimal.Decimal, feeds []string) error {
+	state, err := p.roundState()
+	if err != nil {
+		return err
+	}
+	nextRound := openRound(state)
+	if reason := ineligibility(state, nextRound); reason != "" {
+		p.skipSubmission(nextRound, reason)
+		return nil
+	}
+
+	logger.Infow(msg,
 		"round", nextRound,
 		"address", p.initr.Address.Hex(),
 		"jobID", p.initr.JobSpecID,
 	)
-	err = p.createJobRun(nextPrice, nextRound)
+	err = p.createJobRun(nextPrice, nextRound, feeds)
 	if err != nil {
 		return err
 	}
@@ -422,12 +641,128 @@ func (p *PollingDeviationChecker) poll() error {
 	return nil
 }
 
-func (p *PollingDeviationChecker) fetchPrices() (decimal.Decimal, error) {
-	median, err := p.fetcher.Fetch()
-	return median, errors.Wrap(err, "unable to fetch median price")
+func (p *PollingDeviationChecker) roundState() (eth.AggregatorRoundState, error) {
+	state, err := p.client.GetAggregatorRoundState(p.address, p.oracle)
+	return state, errors.Wrap(err, "unable to fetch aggregator round state")
+}
+
+func (p *PollingDeviationChecker) skipSubmission(round *big.Int, reason string) {
+	logger.Infow(
+		fmt.Sprintf("Skipping submission for round %s: %s", round, reason),
+		"round", round,
+		"reason", reason,
+		"address", p.initr.Address.Hex(),
+		"jobID", p.initr.JobSpecID,
+	)
+	numberSubmissionsSkipped.WithLabelValues(reason).Inc()
+}
+
+// Reasons for which an oracle is not eligible to answer a round.
+const (
+	ineligibleAlreadyAnswered         = "already_answered"
+	ineligibleRoundNotOpen            = "round_not_open"
+	ineligiblePreviousRoundUnfinished = "previous_round_unfinished"
+	ineligibleInsufficientFunds       = "insufficient_funds"
+)
+
+// openRound returns the round that answers can be submitted to: the reporting
+// round while it is in progress, or else the round after it.
+func openRound(state eth.AggregatorRoundState) *big.Int {
+	if state.ReportingRound.Sign() > 0 && !state.ReportingRoundFinished && !state.ReportingRoundTimedOut {
+		return new(big.Int).Set(state.ReportingRound)
+	}
+	return new(big.Int).Add(state.ReportingRound, big.NewInt(1))
+}
+
+// ineligibility returns the reason an answer for the given round would be
+// rejected by the aggregator, or an empty string if it would be accepted.
+func ineligibility(state eth.AggregatorRoundState, round *big.Int) string {
+	nextRound := new(big.Int).Add(state.ReportingRound, big.NewInt(1))
+	switch {
+	case state.LastReportedRound.Cmp(round) >= 0:
+		return ineligibleAlreadyAnswered
+	case round.Cmp(state.ReportingRound) != 0 && round.Cmp(nextRound) != 0:
+		return ineligibleRoundNotOpen
+	case round.Cmp(nextRound) == 0 && openRound(state).Cmp(nextRound) != 0:
+		return ineligiblePreviousRoundUnfinished
+	case state.AvailableFunds.Cmp(state.PaymentAmount) < 0:
+		return ineligibleInsufficientFunds
+	}
+	return ""
+}
+
+// idleTimeout returns a channel that fires once the idle threshold has passed
+// since the last submitted answer, or nil if there is no idle threshold.
+// Heartbeats which did not submit an answer, because they failed or the
+// oracle was not eligible, are retried with an increasing backoff.
+func (p *PollingDeviationChecker) idleTimeout() <-chan time.Time {
+	if p.idleThreshold <= 0 {
+		return nil
+	}
+	deadline := p.lastSubmittedAt.Add(p.idleThreshold)
+	if p.heartbeatRetryAt.After(deadline) {
+		deadline = p.heartbeatRetryAt
+	}
+	return time.After(time.Until(deadline))
 }
 
-func (p *PollingDeviationChecker) createJobRun(nextPrice decimal.Decimal, nextRound *big.Int) error {
+// outsideThresholds checks whether the next price deviates enough from the
+// current price to start a new round.
+func (p *PollingDeviationChecker) outsideThresholds(nextPrice decimal.Decimal) bool {
+	if p.absoluteThreshold == nil {
+		return OutsideDeviation(p.currentPrice, nextPrice, p.threshold)
+	}
+
+	absolute := OutsideAbsoluteDeviation(p.currentPrice, nextPrice, *p.absoluteThreshold)
+	if p.threshold <= 0 {
+		return absolute
+	}
+	relative := OutsideDeviation(p.currentPrice, nextPrice, p.threshold)
+	if p.thresholdMode == models.ThresholdModeAnd {
+		return relative && absolute
+	}
+	return relative || absolute
+}
+
+// fetchPrices returns the median price, along with the feeds that contributed
+// to it if the fetcher reports them. A median outside of the answer bounds is
+// returned as an error, so that it is never submitted.
+func (p *PollingDeviationChecker) fetchPrices() (decimal.Decimal, []string, error) {
+	polledAt := time.Now()
+	p.updateStatus(func(status *models.FluxMonitorStatus) {
+		status.LastPolledAt = &polledAt
+	})
+
+	var median decimal.Decimal
+	var feeds []string
+	var err error
+	if mff, ok := p.fetcher.(MultiFeedFetcher); ok {
+		median, feeds, err = mff.FetchWithFeeds()
+	} else {
+		median, err = p.fetcher.Fetch()
+	}
+	if err != nil {
+		return median, nil, errors.Wrap(err, "unable to fetch median price")
+	}
+	p.updateStatus(func(status *models.FluxMonitorStatus) {
+		status.LastFetchedPrice = &median
+	})
+
+	if (p.minAnswer != nil && median.LessThan(*p.minAnswer)) || (p.maxAnswer != nil && median.GreaterThan(*p.maxAnswer)) {
+		logger.Warnw(
+			"Median price outside of answer bounds, refusing to submit",
+			"price", median,
+			"minAnswer", p.minAnswer,
+			"maxAnswer", p.maxAnswer,
+			"address", p.initr.Address.Hex(),
+			"jobID", p.initr.JobSpecID,
+		)
+		return decimal.Decimal{}, nil, fmt.Errorf("median price %s is outside of the answer bounds", median)
+	}
+	return median, feeds, nil
+}
+
+func (p *PollingDeviationChecker) createJobRun(nextPrice decimal.Decimal, nextRound *big.Int, feeds []string) error {
 	aggregatorContract, err := eth.GetV5Contract(eth.PrepaidAggregatorName)
 	if err != nil {
 		return err
@@ -456,8 +791,28 @@ func (p *PollingDeviationChecker) createJobRun(nextPrice decimal.Decimal, nextRo
 	if err != nil {
 		return errors.Wrap(err, fmt.Sprintf("unable to start chainlink run with payload %s", payload))
 	}
-	_, err = p.runManager.Create(p.initr.JobSpecID, &p.initr, &runData, nil, models.NewRunRequest())
-	return err
+	// Answers are only sent from the oracle, as it is the key whose
+	// eligibility to answer the round was checked.
+	if p.oracle != (common.Address{}) {
+		runData, err = runData.Add("fromAddresses", []common.Address{p.oracle})
+		if err != nil {
+			return err
+		}
+	}
+	runRequest := models.NewRunRequest()
+	runRequest.ContributingFeeds = feeds
+	_, err = p.runManager.Create(p.initr.JobSpecID, &p.initr, &runData, nil, runRequest)
+	if err != nil {
+		return err
+	}
+
+	p.lastSubmittedAt = time.Now()
+	p.heartbeatBackoff.Reset()
+	submittedAt := p.lastSubmittedAt
+	p.updateStatus(func(status *models.FluxMonitorStatus) {
+		status.LastSubmittedAt = &submittedAt
+	})
+	return nil
 }
 
 var dec0 = decimal.NewFromInt(0)
@@ -489,3 +844,26 @@ func OutsideDeviation(curPrice, nextPrice decimal.Decimal, threshold float64) bo
 	)
 	return true
 }
+
+// OutsideAbsoluteDeviation checks whether the next price differs from the
+// current price by at least the absolute threshold.
+func OutsideAbsoluteDeviation(curPrice, nextPrice, threshold decimal.Decimal) bool {
+	diff := curPrice.Sub(nextPrice).Abs()
+	if diff.LessThan(threshold) {
+		logger.Debugw(
+			"Absolute deviation threshold not met",
+			"difference", diff,
+			"threshold", threshold,
+			"currentPrice", curPrice,
+			"nextPrice", nextPrice)
+		return false
+	}
+	logger.Infow(
+		"Absolute deviation threshold met",
+		"difference", diff,
+		"threshold", threshold,
+		"currentPrice", curPrice,
+		"nextPrice", nextPrice,
+	)
+	return true
+}
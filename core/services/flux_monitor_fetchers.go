@@ -12,10 +12,23 @@ import (
 
 	"github.com/guregu/null"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/shopspring/decimal"
 	"go.uber.org/multierr"
 )
 
+var (
+	feedFetchDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "flux_monitor_feed_fetch_seconds",
+		Help: "The time taken to fetch a price from a flux monitor feed",
+	}, []string{"feed"})
+	numberFeedFetchErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "flux_monitor_feed_fetch_errors",
+		Help: "The number of failed or timed out fetches from a flux monitor feed",
+	}, []string{"feed"})
+)
+
 //go:generate mockery -name Fetcher -output ../internal/mocks/ -case=underscore
 
 // Fetcher is the interface encapsulating all functionality needed to retrieve
@@ -97,17 +110,29 @@ func (pr adapterResponse) Result() *decimal.Decimal {
 	return pr.Data.Result
 }
 
-// medianFetcher fetches from all fetchers, and returns the median value, or
-// average if even number of results.
+// MultiFeedFetcher is a Fetcher aggregating the prices of several feeds, which
+// can report the feeds that contributed to a price.
+type MultiFeedFetcher interface {
+	Fetcher
+	FetchWithFeeds() (decimal.Decimal, []string, error)
+}
+
+// medianFetcher fetches from all fetchers concurrently, and returns the median
+// value, or average if even number of results. Fetchers that fail or do not
+// respond before the deadline are excluded from the median.
 type medianFetcher struct {
-	fetchers []Fetcher
+	fetchers         []Fetcher
+	timeout          time.Duration
+	minimumResponses uint32
 }
 
 // newMedianFetcherFromURLs creates a median fetcher that retrieves a price
-// from all passed URLs using httpFetcher, and returns the median.
+// from all passed URLs using httpFetcher, and returns the median. At least
+// minimumResponses feeds must respond, or a majority of them if zero.
 func newMedianFetcherFromURLs(
 	timeout time.Duration,
 	requestData string,
+	minimumResponses uint32,
 	priceURLs ...string,
 ) (Fetcher, error) {
 	fetchers := []Fetcher{}
@@ -120,38 +145,92 @@ func newMedianFetcherFromURLs(
 		fetchers = append(fetchers, ps)
 	}
 
+	if minimumResponses > uint32(len(fetchers)) {
+		return nil, fmt.Errorf("minimum responses %d exceeds the %d feeds of newMedianFetcher", minimumResponses, len(fetchers))
+	}
+
 	medianFetcher, err := newMedianFetcher(fetchers...)
 	if err != nil {
 		return nil, err
 	}
+	medianFetcher.timeout = timeout
+	medianFetcher.minimumResponses = minimumResponses
 
 	return medianFetcher, nil
 }
 
-func newMedianFetcher(fetchers ...Fetcher) (Fetcher, error) {
+func newMedianFetcher(fetchers ...Fetcher) (*medianFetcher, error) {
 	if len(fetchers) == 0 {
 		return nil, errors.New("must pass in at least one price fetcher to newMedianFetcher")
 	}
 	return &medianFetcher{
 		fetchers: fetchers,
+		timeout:  defaultHTTPTimeout,
 	}, nil
 }
 
 func (m *medianFetcher) Fetch() (decimal.Decimal, error) {
-	var err error
-	prices := make([]decimal.Decimal, len(m.fetchers))
+	median, _, err := m.FetchWithFeeds()
+	return median, err
+}
+
+type feedResponse struct {
+	index int
+	price decimal.Decimal
+	err   error
+}
+
+// FetchWithFeeds returns the median price of the feeds that responded before
+// the deadline, along with the names of those feeds.
+func (m *medianFetcher) FetchWithFeeds() (decimal.Decimal, []string, error) {
+	// Buffered, so that fetchers still running after the deadline don't block.
+	responses := make(chan feedResponse, len(m.fetchers))
+	for i, fetcher := range m.fetchers {
+		go func(i int, fetcher Fetcher) {
+			start := time.Now()
+			price, err := fetcher.Fetch()
+			feedFetchDuration.WithLabelValues(feedName(fetcher)).Observe(time.Since(start).Seconds())
+			responses <- feedResponse{index: i, price: price, err: err}
+		}(i, fetcher)
+	}
+
+	responded := make([]bool, len(m.fetchers))
+	prices := []decimal.Decimal{}
+	feeds := []string{}
 	fetchErrors := []error{}
+	deadline := time.After(m.timeout)
+Collect:
+	for range m.fetchers {
+		select {
+		case response := <-responses:
+			responded[response.index] = true
+			name := feedName(m.fetchers[response.index])
+			if response.err != nil {
+				logger.Error(response.err)
+				numberFeedFetchErrors.WithLabelValues(name).Inc()
+				fetchErrors = append(fetchErrors, response.err)
+				continue
+			}
+			prices = append(prices, response.price)
+			feeds = append(feeds, name)
+		case <-deadline:
+			break Collect
+		}
+	}
+
 	for i, fetcher := range m.fetchers {
-		prices[i], err = fetcher.Fetch()
-		if err != nil {
-			logger.Error(err)
-			fetchErrors = append(fetchErrors, err)
+		if !responded[i] {
+			name := feedName(fetcher)
+			numberFeedFetchErrors.WithLabelValues(name).Inc()
+			fetchErrors = append(fetchErrors, fmt.Errorf("%s did not respond within %v", name, m.timeout))
 		}
 	}
 
-	errorRate := float64(len(fetchErrors)) / float64(len(m.fetchers))
-	if errorRate >= 0.5 {
-		return decimal.Decimal{}, errors.Wrap(multierr.Combine(fetchErrors...), "majority of fetchers in median failed")
+	if len(prices) < m.requiredResponses() {
+		return decimal.Decimal{}, nil, errors.Wrap(
+			multierr.Combine(fetchErrors...),
+			fmt.Sprintf("%d of %d fetchers in median responded, at least %d required", len(prices), len(m.fetchers), m.requiredResponses()),
+		)
 	}
 
 	sort.Slice(prices, func(i, j int) bool {
@@ -159,9 +238,18 @@ func (m *medianFetcher) Fetch() (decimal.Decimal, error) {
 	})
 	k := len(prices) / 2
 	if len(prices)%2 == 1 {
-		return prices[k], nil
+		return prices[k], feeds, nil
 	}
-	return prices[k].Add(prices[k-1]).Div(decimal.NewFromInt(2)), nil
+	return prices[k].Add(prices[k-1]).Div(decimal.NewFromInt(2)), feeds, nil
+}
+
+// requiredResponses returns the number of fetchers that must respond, being
+// a majority of them unless configured otherwise.
+func (m *medianFetcher) requiredResponses() int {
+	if m.minimumResponses > 0 {
+		return int(m.minimumResponses)
+	}
+	return len(m.fetchers)/2 + 1
 }
 
 func (m *medianFetcher) String() string {
@@ -171,3 +259,13 @@ func (m *medianFetcher) String() string {
 	}
 	return fmt.Sprintf("median fetcher: %s", strings.Join(fetcherDescriptions, ","))
 }
+
+// feedName identifies a fetcher in metrics and run reports, by the scheme and
+// host of its URL if it fetches over HTTP. The rest of the URL is left out as
+// it can hold API keys.
+func feedName(fetcher Fetcher) string {
+	if hf, ok := fetcher.(*httpFetcher); ok {
+		return fmt.Sprintf("%s://%s", hf.url.Scheme, hf.url.Host)
+	}
+	return fmt.Sprint(fetcher)
+}
@@ -21,6 +21,7 @@ func ExportedSetCheckerFactory(fm FluxMonitor, fac DeviationCheckerFactory) {
 }
 
 func (p *PollingDeviationChecker) ExportedFetchAggregatorData(client eth.Client) error {
+	p.client = client
 	return p.fetchAggregatorData(client)
 }
 
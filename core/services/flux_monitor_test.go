@@ -1,6 +1,7 @@
 package services_test
 
 import (
+	"chainlink/core/eth"
 	"chainlink/core/internal/cltest"
 	"chainlink/core/internal/mocks"
 	"chainlink/core/services"
@@ -13,6 +14,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/onsi/gomega"
 	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
@@ -27,6 +29,18 @@ func fakeSubscription() *mocks.Subscription {
 	return sub
 }
 
+// openRoundState returns an aggregator round state in which round has
+// finished and the oracle is eligible to answer the round after it.
+func openRoundState(round int64) eth.AggregatorRoundState {
+	return eth.AggregatorRoundState{
+		ReportingRound:         big.NewInt(round),
+		ReportingRoundFinished: true,
+		LastReportedRound:      big.NewInt(round),
+		AvailableFunds:         big.NewInt(100),
+		PaymentAmount:          big.NewInt(1),
+	}
+}
+
 func TestConcreteFluxMonitor_AddJobRemoveJobHappy(t *testing.T) {
 	store, cleanup := cltest.NewStore(t)
 	defer cleanup()
@@ -42,7 +56,7 @@ func TestConcreteFluxMonitor_AddJobRemoveJobHappy(t *testing.T) {
 
 	checkerFactory := new(mocks.DeviationCheckerFactory)
 	checkerFactory.On("New", job.Initiators[0], runManager).Return(dc, nil)
-	fm := services.NewFluxMonitor(store, runManager)
+	fm := services.NewFluxMonitor(store, runManager, services.NewLogBroadcaster(store.TxManager))
 	services.ExportedSetCheckerFactory(fm, checkerFactory)
 	require.NoError(t, fm.Start())
 	defer fm.Stop()
@@ -70,6 +84,47 @@ func TestConcreteFluxMonitor_AddJobRemoveJobHappy(t *testing.T) {
 	dc.AssertExpectations(t)
 }
 
+func TestConcreteFluxMonitor_JobStatus(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithFluxMonitorInitiator()
+	runManager := new(mocks.RunManager)
+	status := models.FluxMonitorStatus{InitiatorID: 1, Connected: true}
+
+	dc := new(mocks.DeviationChecker)
+	dc.On("Status").Return(status)
+
+	checkerFactory := new(mocks.DeviationCheckerFactory)
+	checkerFactory.On("New", job.Initiators[0], runManager).Return(dc, nil)
+	fm := services.NewFluxMonitor(store, runManager, services.NewLogBroadcaster(store.TxManager))
+	services.ExportedSetCheckerFactory(fm, checkerFactory)
+	require.NoError(t, fm.Start())
+	defer fm.Stop()
+
+	assert.Empty(t, fm.JobStatus(job.ID))
+
+	require.NoError(t, fm.AddJob(job))
+	assert.Equal(t, []models.FluxMonitorStatus{status}, fm.JobStatus(job.ID))
+	assert.Empty(t, fm.JobStatus(models.NewID()))
+}
+
+func TestConcreteFluxMonitor_JobStatusWhenNotRunning(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	fm := services.NewFluxMonitor(store, new(mocks.RunManager), services.NewLogBroadcaster(store.TxManager))
+	cltest.CallbackOrTimeout(t, "status before start", func() {
+		assert.Empty(t, fm.JobStatus(models.NewID()))
+	})
+
+	require.NoError(t, fm.Start())
+	fm.Stop()
+	cltest.CallbackOrTimeout(t, "status after stop", func() {
+		assert.Empty(t, fm.JobStatus(models.NewID()))
+	})
+}
+
 func TestConcreteFluxMonitor_AddJobError(t *testing.T) {
 	store, cleanup := cltest.NewStore(t)
 	defer cleanup()
@@ -80,7 +135,7 @@ func TestConcreteFluxMonitor_AddJobError(t *testing.T) {
 	dc.On("Start", mock.Anything, mock.Anything).Return(errors.New("deliberate test error"))
 	checkerFactory := new(mocks.DeviationCheckerFactory)
 	checkerFactory.On("New", job.Initiators[0], runManager).Return(dc, nil)
-	fm := services.NewFluxMonitor(store, runManager)
+	fm := services.NewFluxMonitor(store, runManager, services.NewLogBroadcaster(store.TxManager))
 	services.ExportedSetCheckerFactory(fm, checkerFactory)
 	require.NoError(t, fm.Start())
 	defer fm.Stop()
@@ -101,7 +156,7 @@ func TestConcreteFluxMonitor_AddJobDisconnected(t *testing.T) {
 	checkerFactory := new(mocks.DeviationCheckerFactory)
 	dc := new(mocks.DeviationChecker)
 	checkerFactory.On("New", job.Initiators[0], runManager).Return(dc, nil)
-	fm := services.NewFluxMonitor(store, runManager)
+	fm := services.NewFluxMonitor(store, runManager, services.NewLogBroadcaster(store.TxManager))
 	services.ExportedSetCheckerFactory(fm, checkerFactory)
 	require.NoError(t, fm.Start())
 	defer fm.Stop()
@@ -116,7 +171,7 @@ func TestConcreteFluxMonitor_AddJobNonFluxMonitor(t *testing.T) {
 	job := cltest.NewJobWithRunLogInitiator()
 	runManager := new(mocks.RunManager)
 	checkerFactory := new(mocks.DeviationCheckerFactory)
-	fm := services.NewFluxMonitor(store, runManager)
+	fm := services.NewFluxMonitor(store, runManager, services.NewLogBroadcaster(store.TxManager))
 	services.ExportedSetCheckerFactory(fm, checkerFactory)
 	require.NoError(t, fm.Start())
 	defer fm.Stop()
@@ -144,7 +199,7 @@ func TestConcreteFluxMonitor_ConnectStartsExistingJobs(t *testing.T) {
 
 	checkerFactory := new(mocks.DeviationCheckerFactory)
 	checkerFactory.On("New", job.Initiators[0], runManager).Return(dc, nil)
-	fm := services.NewFluxMonitor(store, runManager)
+	fm := services.NewFluxMonitor(store, runManager, services.NewLogBroadcaster(store.TxManager))
 	services.ExportedSetCheckerFactory(fm, checkerFactory)
 	require.NoError(t, fm.Start())
 	defer fm.Stop()
@@ -162,7 +217,7 @@ func TestConcreteFluxMonitor_StopWithoutStart(t *testing.T) {
 
 	runManager := new(mocks.RunManager)
 
-	fm := services.NewFluxMonitor(store, runManager)
+	fm := services.NewFluxMonitor(store, runManager, services.NewLogBroadcaster(store.TxManager))
 	fm.Stop()
 }
 
@@ -200,6 +255,8 @@ func TestPollingDeviationChecker_PollHappy(t *testing.T) {
 	assert.Equal(t, decimal.NewFromInt(100), checker.ExportedCurrentPrice())
 	assert.Equal(t, big.NewInt(1), checker.ExportedCurrentRound())
 
+	ethClient.On("GetAggregatorRoundState", initr.InitiatorParams.Address, mock.Anything).
+		Return(openRoundState(1), nil)
 	require.NoError(t, checker.ExportedPoll()) // main entry point
 
 	fetcher.AssertExpectations(t)
@@ -208,6 +265,162 @@ func TestPollingDeviationChecker_PollHappy(t *testing.T) {
 	assert.Equal(t, big.NewInt(2), checker.ExportedCurrentRound())
 }
 
+type multiFeedFetcher struct {
+	price decimal.Decimal
+	feeds []string
+}
+
+func (f multiFeedFetcher) Fetch() (decimal.Decimal, error) {
+	return f.price, nil
+}
+
+func (f multiFeedFetcher) FetchWithFeeds() (decimal.Decimal, []string, error) {
+	return f.price, f.feeds, nil
+}
+
+func TestPollingDeviationChecker_PollRecordsContributingFeeds(t *testing.T) {
+	feeds := []string{"https://lambda.staging.devnet.tools/bnc/call", "https://lambda.staging.devnet.tools/cc/call"}
+	fetcher := multiFeedFetcher{price: decimal.NewFromInt(102), feeds: feeds}
+
+	job := cltest.NewJobWithFluxMonitorInitiator()
+	initr := job.Initiators[0]
+	initr.ID = 1
+
+	rm := new(mocks.RunManager)
+	run := cltest.NewJobRun(job)
+	rm.On("Create", job.ID, &initr, mock.Anything, mock.Anything, mock.MatchedBy(func(runRequest *models.RunRequest) bool {
+		return assert.ObjectsAreEqual(models.Feeds(feeds), runRequest.ContributingFeeds)
+	})).Return(&run, nil)
+
+	checker, err := services.NewPollingDeviationChecker(initr, rm, fetcher, time.Second)
+	require.NoError(t, err)
+
+	ethClient := new(mocks.Client)
+	ethClient.On("GetAggregatorPrice", initr.InitiatorParams.Address, initr.InitiatorParams.Precision).
+		Return(decimal.NewFromInt(100), nil)
+	ethClient.On("GetAggregatorRound", initr.InitiatorParams.Address).
+		Return(big.NewInt(1), nil)
+	ethClient.On("GetAggregatorRoundState", initr.InitiatorParams.Address, mock.Anything).
+		Return(openRoundState(1), nil)
+	require.NoError(t, checker.ExportedFetchAggregatorData(ethClient))
+
+	require.NoError(t, checker.ExportedPoll())
+	rm.AssertExpectations(t)
+}
+
+func TestPollingDeviationChecker_PollSubmitsFromOracle(t *testing.T) {
+	fetcher := new(mocks.Fetcher)
+	fetcher.On("Fetch").Return(decimal.NewFromInt(102), nil)
+
+	job := cltest.NewJobWithFluxMonitorInitiator()
+	initr := job.Initiators[0]
+	initr.ID = 1
+	initr.FromAddresses = models.AddressCollection{cltest.NewAddress(), cltest.NewAddress()}
+
+	rm := new(mocks.RunManager)
+	run := cltest.NewJobRun(job)
+	rm.On("Create", job.ID, &initr, mock.MatchedBy(func(data *models.JSON) bool {
+		from := data.Get("fromAddresses").Array()
+		return len(from) == 1 &&
+			common.HexToAddress(from[0].String()) == initr.FromAddresses[0]
+	}), mock.Anything, mock.Anything).Return(&run, nil)
+
+	checker, err := services.NewPollingDeviationChecker(initr, rm, fetcher, time.Second)
+	require.NoError(t, err)
+
+	ethClient := new(mocks.Client)
+	ethClient.On("GetAggregatorPrice", initr.InitiatorParams.Address, initr.InitiatorParams.Precision).
+		Return(decimal.NewFromInt(100), nil)
+	ethClient.On("GetAggregatorRound", initr.InitiatorParams.Address).
+		Return(big.NewInt(1), nil)
+	ethClient.On("GetAggregatorRoundState", initr.InitiatorParams.Address, initr.FromAddresses[0]).
+		Return(openRoundState(1), nil)
+	require.NoError(t, checker.ExportedFetchAggregatorData(ethClient))
+
+	require.NoError(t, checker.ExportedPoll())
+	ethClient.AssertExpectations(t)
+	rm.AssertExpectations(t)
+}
+
+func TestPollingDeviationChecker_IdleThresholdSubmitsHeartbeat(t *testing.T) {
+	job := cltest.NewJobWithFluxMonitorInitiator()
+	initr := job.Initiators[0]
+	initr.ID = 1
+	initr.IdleThreshold = models.Duration(10 * time.Millisecond)
+
+	// Aggregator and feeds agree, so only the idle threshold starts a round
+	ethClient := new(mocks.Client)
+	ethClient.On("GetAggregatorPrice", initr.InitiatorParams.Address, initr.InitiatorParams.Precision).
+		Return(decimal.NewFromInt(100), nil)
+	ethClient.On("GetAggregatorRound", initr.InitiatorParams.Address).
+		Return(big.NewInt(1), nil)
+	ethClient.On("GetAggregatorRoundState", initr.InitiatorParams.Address, mock.Anything).
+		Return(openRoundState(1), nil)
+	ethClient.On("SubscribeToLogs", mock.Anything, mock.Anything).
+		Return(fakeSubscription(), nil)
+
+	fetcher := new(mocks.Fetcher)
+	fetcher.On("Fetch").Return(decimal.NewFromInt(100), nil)
+
+	submitted := make(chan struct{}, 1)
+	rm := new(mocks.RunManager)
+	run := cltest.NewJobRun(job)
+	rm.On("Create", job.ID, &initr, mock.Anything, mock.Anything, mock.Anything).
+		Return(&run, nil).
+		Run(func(mock.Arguments) {
+			select {
+			case submitted <- struct{}{}:
+			default:
+			}
+		})
+
+	checker, err := services.NewPollingDeviationChecker(initr, rm, fetcher, time.Hour)
+	require.NoError(t, err)
+	require.NoError(t, checker.Start(context.Background(), ethClient))
+	defer checker.Stop()
+
+	cltest.CallbackOrTimeout(t, "heartbeat submitted", func() {
+		<-submitted
+	})
+}
+
+func TestPollingDeviationChecker_IdleThresholdBacksOffFailedHeartbeats(t *testing.T) {
+	job := cltest.NewJobWithFluxMonitorInitiator()
+	initr := job.Initiators[0]
+	initr.ID = 1
+	initr.IdleThreshold = models.Duration(10 * time.Millisecond)
+
+	ethClient := new(mocks.Client)
+	ethClient.On("GetAggregatorPrice", initr.InitiatorParams.Address, initr.InitiatorParams.Precision).
+		Return(decimal.NewFromInt(100), nil)
+	ethClient.On("GetAggregatorRound", initr.InitiatorParams.Address).
+		Return(big.NewInt(1), nil)
+	ethClient.On("SubscribeToLogs", mock.Anything, mock.Anything).
+		Return(fakeSubscription(), nil)
+
+	// The feeds answer the initial poll, and then fail every heartbeat
+	heartbeats := make(chan struct{}, 100)
+	fetcher := new(mocks.Fetcher)
+	fetcher.On("Fetch").Return(decimal.NewFromInt(100), nil).Once()
+	fetcher.On("Fetch").Return(decimal.Decimal{}, errors.New("feed unavailable")).
+		Run(func(mock.Arguments) { heartbeats <- struct{}{} })
+
+	rm := new(mocks.RunManager)
+	checker, err := services.NewPollingDeviationChecker(initr, rm, fetcher, time.Hour)
+	require.NoError(t, err)
+	require.NoError(t, checker.Start(context.Background(), ethClient))
+	defer checker.Stop()
+
+	cltest.CallbackOrTimeout(t, "heartbeat attempted", func() {
+		<-heartbeats
+	})
+
+	// The failed heartbeat is retried after a backoff, not straight away
+	time.Sleep(100 * time.Millisecond)
+	assert.Len(t, heartbeats, 0)
+	rm.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
 func TestPollingDeviationChecker_StartError(t *testing.T) {
 	rm := new(mocks.RunManager)
 	job := cltest.NewJobWithFluxMonitorInitiator()
@@ -397,6 +610,9 @@ func TestPollingDeviationChecker_RespondToNewRound_Respond(t *testing.T) {
 	}`, initr.InitiatorParams.Address.Hex()))) // dataPrefix has currentRound + 1
 	require.NoError(t, err)
 
+	ethClient.On("GetAggregatorRoundState", initr.InitiatorParams.Address, mock.Anything).
+		Return(openRoundState(currentRound), nil)
+
 	// Set up fetcher for 100; even if within deviation, forces the creation of run.
 	fetcher.On("Fetch").Return(decimal.NewFromFloat(100.0), nil).Maybe()
 
@@ -430,3 +646,239 @@ func TestOutsideDeviation(t *testing.T) {
 		})
 	}
 }
+
+func TestOutsideAbsoluteDeviation(t *testing.T) {
+	tests := []struct {
+		name                           string
+		curPrice, nextPrice, threshold decimal.Decimal
+		expectation                    bool
+	}{
+		{"inside deviation", decimal.NewFromInt(1), decimal.RequireFromString("1.004"), decimal.RequireFromString("0.005"), false},
+		{"equal to deviation", decimal.NewFromInt(1), decimal.RequireFromString("0.995"), decimal.RequireFromString("0.005"), true},
+		{"outside deviation", decimal.NewFromInt(1), decimal.RequireFromString("1.01"), decimal.RequireFromString("0.005"), true},
+		{"0 current price", decimal.NewFromInt(0), decimal.RequireFromString("0.001"), decimal.RequireFromString("0.005"), false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := services.OutsideAbsoluteDeviation(test.curPrice, test.nextPrice, test.threshold)
+			assert.Equal(t, test.expectation, actual)
+		})
+	}
+}
+
+func TestPollingDeviationChecker_PollThresholds(t *testing.T) {
+	absolute := decimal.NewFromInt(5)
+	minAnswer := decimal.NewFromInt(50)
+	maxAnswer := decimal.NewFromInt(150)
+
+	tests := []struct {
+		name              string
+		threshold         float32
+		absoluteThreshold *decimal.Decimal
+		mode              models.ThresholdMode
+		nextPrice         int64
+		expectRun         bool
+	}{
+		{"absolute only, inside", 0, &absolute, "", 104, false},
+		{"absolute only, outside", 0, &absolute, "", 105, true},
+		{"or, relative met", 2, &absolute, models.ThresholdModeOr, 103, true},
+		{"or, neither met", 2, &absolute, models.ThresholdModeOr, 101, false},
+		{"and, only relative met", 2, &absolute, models.ThresholdModeAnd, 103, false},
+		{"and, both met", 2, &absolute, models.ThresholdModeAnd, 106, true},
+		{"below minAnswer", 2, nil, "", 40, false},
+		{"above maxAnswer", 2, nil, "", 160, false},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			job := cltest.NewJobWithFluxMonitorInitiator()
+			initr := job.Initiators[0]
+			initr.ID = 1
+			initr.Threshold = test.threshold
+			initr.AbsoluteThreshold = test.absoluteThreshold
+			initr.ThresholdMode = test.mode
+			initr.MinAnswer = &minAnswer
+			initr.MaxAnswer = &maxAnswer
+
+			fetcher := new(mocks.Fetcher)
+			fetcher.On("Fetch").Return(decimal.NewFromInt(test.nextPrice), nil)
+
+			rm := new(mocks.RunManager)
+			if test.expectRun {
+				run := cltest.NewJobRun(job)
+				rm.On("Create", job.ID, &initr, mock.Anything, mock.Anything, mock.Anything).
+					Return(&run, nil)
+			}
+
+			checker, err := services.NewPollingDeviationChecker(initr, rm, fetcher, time.Second)
+			require.NoError(t, err)
+
+			ethClient := new(mocks.Client)
+			ethClient.On("GetAggregatorPrice", initr.InitiatorParams.Address, initr.InitiatorParams.Precision).
+				Return(decimal.NewFromInt(100), nil)
+			ethClient.On("GetAggregatorRound", initr.InitiatorParams.Address).
+				Return(big.NewInt(1), nil)
+			ethClient.On("GetAggregatorRoundState", initr.InitiatorParams.Address, mock.Anything).
+				Return(openRoundState(1), nil).Maybe()
+			require.NoError(t, checker.ExportedFetchAggregatorData(ethClient))
+
+			err = checker.ExportedPoll()
+			if test.nextPrice < 50 || test.nextPrice > 150 {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			rm.AssertExpectations(t)
+			if !test.expectRun {
+				rm.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestPollingDeviationChecker_PollSkipsIneligibleRounds(t *testing.T) {
+	unfinished := openRoundState(2)
+	unfinished.ReportingRoundFinished = false
+	unfinished.LastReportedRound = big.NewInt(1)
+
+	answered := openRoundState(2)
+	answered.ReportingRoundFinished = false
+
+	timedOut := unfinished
+	timedOut.ReportingRoundTimedOut = true
+
+	unfunded := openRoundState(2)
+	unfunded.AvailableFunds = big.NewInt(0)
+
+	tests := []struct {
+		name      string
+		state     eth.AggregatorRoundState
+		expectRun bool
+		wantRound int64
+	}{
+		{"next round open", openRoundState(2), true, 3},
+		{"joins unfinished round", unfinished, true, 2},
+		{"unfinished round already answered", answered, false, 1},
+		{"previous round timed out", timedOut, true, 3},
+		{"insufficient funds", unfunded, false, 1},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			job := cltest.NewJobWithFluxMonitorInitiator()
+			initr := job.Initiators[0]
+			initr.ID = 1
+
+			fetcher := new(mocks.Fetcher)
+			fetcher.On("Fetch").Return(decimal.NewFromInt(110), nil)
+
+			rm := new(mocks.RunManager)
+			if test.expectRun {
+				run := cltest.NewJobRun(job)
+				rm.On("Create", job.ID, &initr, mock.Anything, mock.Anything, mock.Anything).
+					Return(&run, nil)
+			}
+
+			checker, err := services.NewPollingDeviationChecker(initr, rm, fetcher, time.Second)
+			require.NoError(t, err)
+
+			ethClient := new(mocks.Client)
+			ethClient.On("GetAggregatorPrice", initr.InitiatorParams.Address, initr.InitiatorParams.Precision).
+				Return(decimal.NewFromInt(100), nil)
+			ethClient.On("GetAggregatorRound", initr.InitiatorParams.Address).
+				Return(big.NewInt(1), nil)
+			ethClient.On("GetAggregatorRoundState", initr.InitiatorParams.Address, mock.Anything).
+				Return(test.state, nil)
+			require.NoError(t, checker.ExportedFetchAggregatorData(ethClient))
+
+			require.NoError(t, checker.ExportedPoll())
+
+			rm.AssertExpectations(t)
+			if !test.expectRun {
+				rm.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
+			assert.Equal(t, big.NewInt(test.wantRound), checker.ExportedCurrentRound())
+		})
+	}
+}
+
+func TestPollingDeviationChecker_RespondToNewRound_SkipsAnsweredRound(t *testing.T) {
+	job := cltest.NewJobWithFluxMonitorInitiator()
+	initr := job.Initiators[0]
+	initr.ID = 1
+
+	ethClient := new(mocks.Client)
+	ethClient.On("GetAggregatorPrice", initr.InitiatorParams.Address, initr.InitiatorParams.Precision).
+		Return(decimal.NewFromInt(100), nil)
+	ethClient.On("GetAggregatorRound", initr.InitiatorParams.Address).
+		Return(big.NewInt(5), nil)
+	ethClient.On("GetAggregatorRoundState", initr.InitiatorParams.Address, mock.Anything).
+		Return(openRoundState(6), nil)
+
+	rm := new(mocks.RunManager)
+	fetcher := new(mocks.Fetcher)
+	checker, err := services.NewPollingDeviationChecker(initr, rm, fetcher, time.Minute)
+	require.NoError(t, err)
+	require.NoError(t, checker.ExportedFetchAggregatorData(ethClient))
+
+	log := cltest.LogFromFixture(t, "testdata/new_round_log.json")
+	log.Topics[models.NewRoundTopicRoundID] = common.BytesToHash(utils.EVMWordUint64(6))
+	require.NoError(t, checker.ExportedRespondToNewRound(log))
+
+	fetcher.AssertNotCalled(t, "Fetch")
+	rm.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestPollingDeviationChecker_Status(t *testing.T) {
+	job := cltest.NewJobWithFluxMonitorInitiator()
+	initr := job.Initiators[0]
+	initr.ID = 1
+
+	fetcher := new(mocks.Fetcher)
+	fetcher.On("Fetch").Return(decimal.NewFromInt(102), nil).Once()
+	fetcher.On("Fetch").Return(decimal.Decimal{}, errors.New("no feeds responded"))
+
+	rm := new(mocks.RunManager)
+	run := cltest.NewJobRun(job)
+	rm.On("Create", job.ID, &initr, mock.Anything, mock.Anything, mock.Anything).
+		Return(&run, nil)
+
+	checker, err := services.NewPollingDeviationChecker(initr, rm, fetcher, 10*time.Millisecond)
+	require.NoError(t, err)
+
+	status := checker.Status()
+	assert.Equal(t, initr.ID, status.InitiatorID)
+	assert.Equal(t, initr.Address, status.Address)
+	assert.Nil(t, status.LastPolledAt)
+
+	ethClient := new(mocks.Client)
+	ethClient.On("GetAggregatorPrice", initr.InitiatorParams.Address, initr.InitiatorParams.Precision).
+		Return(decimal.NewFromInt(100), nil)
+	ethClient.On("GetAggregatorRound", initr.InitiatorParams.Address).
+		Return(big.NewInt(1), nil)
+	ethClient.On("GetAggregatorRoundState", initr.InitiatorParams.Address, mock.Anything).
+		Return(openRoundState(1), nil)
+	ethClient.On("SubscribeToLogs", mock.Anything, mock.Anything).
+		Return(fakeSubscription(), nil)
+
+	require.NoError(t, checker.Start(context.Background(), ethClient))
+	defer checker.Stop()
+
+	status = checker.Status()
+	assert.True(t, status.Connected)
+	assert.Equal(t, decimal.NewFromInt(102), status.CurrentPrice)
+	assert.Equal(t, big.NewInt(2), status.CurrentRound.ToInt())
+	require.NotNil(t, status.LastFetchedPrice)
+	assert.Equal(t, decimal.NewFromInt(102), *status.LastFetchedPrice)
+	assert.NotNil(t, status.LastPolledAt)
+	assert.NotNil(t, status.LastSubmittedAt)
+	assert.Empty(t, status.LastError)
+
+	gomega.NewGomegaWithT(t).Eventually(func() string {
+		return checker.Status().LastError
+	}).Should(gomega.ContainSubstring("no feeds responded"))
+}
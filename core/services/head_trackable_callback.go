@@ -12,5 +12,6 @@ func (c *headTrackableCallback) Connect(*models.Head) error {
 	return nil
 }
 
-func (c *headTrackableCallback) Disconnect()            {}
-func (c *headTrackableCallback) OnNewHead(*models.Head) {}
+func (c *headTrackableCallback) Disconnect()                                        {}
+func (c *headTrackableCallback) OnNewHead(*models.Head)                             {}
+func (c *headTrackableCallback) OnReorg(*models.Head, []models.Head, []models.Head) {}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"fmt"
+	"math/big"
 	"sync"
 	"time"
 
@@ -12,6 +13,8 @@ import (
 	"chainlink/core/store/presenters"
 	"chainlink/core/utils"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -22,17 +25,26 @@ var (
 		Name: "head_tracker_heads_received",
 		Help: "The total number of heads seen",
 	})
+	numberReorgs = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "head_tracker_reorgs",
+		Help: "The total number of chain reorganizations detected",
+	})
 )
 
 // HeadTracker holds and stores the latest block number experienced by this particular node
 // in a thread safe manner. Reconstitutes the last block number from the data
 // store on reboot.
+//
+// It also keeps a bounded chain of the most recent heads, which is used to
+// detect chain reorganizations when a new head does not extend the tracked
+// chain.
 type HeadTracker struct {
 	callbacks             []strpkg.HeadTrackable
 	headers               chan eth.BlockHeader
 	headSubscription      eth.Subscription
 	store                 *strpkg.Store
 	head                  *models.Head
+	chain                 []models.Head
 	headMutex             sync.RWMutex
 	connected             bool
 	sleeper               utils.Sleeper
@@ -122,6 +134,7 @@ func (ht *HeadTracker) Save(n *models.Head) error {
 	if n.GreaterThan(ht.head) {
 		copy := *n
 		ht.head = &copy
+		ht.appendToChain(copy)
 		ht.headMutex.Unlock()
 	} else {
 		ht.headMutex.Unlock()
@@ -159,6 +172,28 @@ func (ht *HeadTracker) disconnect() {
 	}
 }
 
+// Chain returns the most recent heads being tracked, ordered from oldest to
+// newest.
+func (ht *HeadTracker) Chain() []models.Head {
+	ht.headMutex.RLock()
+	defer ht.headMutex.RUnlock()
+
+	chain := make([]models.Head, len(ht.chain))
+	copy(chain, ht.chain)
+	return chain
+}
+
+func (ht *HeadTracker) onReorg(ancestor *models.Head, oldHeads, newHeads []models.Head) {
+	numberReorgs.Inc()
+
+	ht.headMutex.Lock()
+	defer ht.headMutex.Unlock()
+
+	for _, trackable := range ht.callbacks {
+		trackable.OnReorg(ancestor, oldHeads, newHeads)
+	}
+}
+
 func (ht *HeadTracker) onNewHead(head *models.Head) {
 	numberHeadsReceived.Inc()
 
@@ -218,13 +253,18 @@ func (ht *HeadTracker) receiveHeaders() error {
 			if !open {
 				return errors.New("HeadTracker headers prematurely closed")
 			}
-			head := models.NewHead(block.Number.ToInt(), block.Hash())
+			head := models.NewHeadFromBlockHeader(block)
 			logger.Debugw(
 				fmt.Sprintf("Received new head %v", presenters.FriendlyBigInt(head.ToInt())),
 				"blockHeight", head.ToInt(),
 				"blockHash", block.Hash(),
+				"parentHash", head.ParentHash,
 				"hash", head.Hash)
-			if err := ht.Save(head); err != nil {
+			if ht.isDisconnectedFromChain(head) {
+				if err := ht.handleDisconnectedHead(head); err != nil {
+					logger.Errorw("Unable to reconcile new head with tracked chain", "error", err, "blockHash", head.Hash)
+				}
+			} else if err := ht.Save(head); err != nil {
 				switch err.(type) {
 				case errBlockNotLater:
 					logger.Warn(err)
@@ -284,9 +324,150 @@ func (ht *HeadTracker) updateHeadFromDb() error {
 		return err
 	}
 	ht.head = number
+
+	chain, err := ht.store.LastHeads(models.HeadsRetained)
+	if err != nil {
+		return err
+	}
+	ht.chain = chain
+	return nil
+}
+
+// appendToChain adds a head to the tracked chain, discarding the oldest
+// heads once more than models.HeadsRetained are tracked. Must be called with
+// headMutex held.
+func (ht *HeadTracker) appendToChain(head models.Head) {
+	ht.chain = append(ht.chain, head)
+	if len(ht.chain) > models.HeadsRetained {
+		ht.chain = ht.chain[len(ht.chain)-models.HeadsRetained:]
+	}
+}
+
+// trackedHead returns the tracked head with the passed block number, or nil.
+// Must be called with headMutex held.
+func (ht *HeadTracker) trackedHead(number int64) *models.Head {
+	for i := len(ht.chain) - 1; i >= 0; i-- {
+		if ht.chain[i].Number == number {
+			return &ht.chain[i]
+		}
+	}
+	return nil
+}
+
+// isDisconnectedFromChain returns true if the passed head is not a head we
+// are already tracking and its parent is not the current head, which means
+// that either some heads were missed or the chain has been reorganized.
+// Heads too far ahead of the tracked chain to be reconciled with it, such as
+// after a long downtime, are simply saved.
+func (ht *HeadTracker) isDisconnectedFromChain(head *models.Head) bool {
+	ht.headMutex.RLock()
+	defer ht.headMutex.RUnlock()
+
+	if ht.head == nil || head.IsChildOf(ht.head) || head.Number > ht.head.Number+models.HeadsRetained {
+		return false
+	}
+	for _, tracked := range ht.chain {
+		if tracked.Hash == head.Hash {
+			return false
+		}
+	}
+	return true
+}
+
+// handleDisconnectedHead walks back from the passed head, fetching its
+// ancestors from the ethereum node, until it finds the latest block common to
+// the tracked chain. The new canonical chain replaces the tracked heads after
+// that common ancestor, and if any tracked heads were orphaned in the process
+// the HeadTrackables are notified of the reorganization.
+func (ht *HeadTracker) handleDisconnectedHead(head *models.Head) error {
+	newHeads := []models.Head{*head}
+	ancestor, err := ht.findCommonAncestor(&newHeads)
+	if err != nil {
+		return err
+	}
+
+	ht.headMutex.Lock()
+	var oldHeads, retained []models.Head
+	for _, tracked := range ht.chain {
+		if ancestor != nil && tracked.Number <= ancestor.Number {
+			retained = append(retained, tracked)
+		} else {
+			oldHeads = append(oldHeads, tracked)
+		}
+	}
+	ht.chain = retained
+	for _, newHead := range newHeads {
+		ht.appendToChain(newHead)
+	}
+	latest := newHeads[len(newHeads)-1]
+	ht.head = &latest
+	ht.headMutex.Unlock()
+
+	deleteAfter := int64(-1)
+	if ancestor != nil {
+		deleteAfter = ancestor.Number
+	}
+	if err := ht.store.DeleteHeadsAfter(deleteAfter); err != nil {
+		return errors.Wrap(err, "HeadTracker#handleDisconnectedHead DeleteHeadsAfter")
+	}
+	for i := range newHeads {
+		if err := ht.store.CreateHead(&newHeads[i]); err != nil {
+			return errors.Wrap(err, "HeadTracker#handleDisconnectedHead CreateHead")
+		}
+	}
+
+	if len(oldHeads) > 0 {
+		logger.Warnw(
+			fmt.Sprintf("Chain reorganization of %d blocks detected", len(oldHeads)),
+			"ancestorHeight", ancestor.ToInt(),
+			"orphanedHeads", len(oldHeads),
+			"newHeads", len(newHeads),
+			"blockHeight", latest.ToInt(),
+			"blockHash", latest.Hash,
+		)
+		ht.onReorg(ancestor, oldHeads, newHeads)
+	}
+	ht.onNewHead(&latest)
 	return nil
 }
 
+// findCommonAncestor prepends the missing ancestors of the first of the passed
+// heads until it reaches a head that is part of the tracked chain, which is
+// returned. Returns nil if no common ancestor exists within the tracked chain.
+func (ht *HeadTracker) findCommonAncestor(newHeads *[]models.Head) (*models.Head, error) {
+	for {
+		earliest := (*newHeads)[0]
+
+		ht.headMutex.RLock()
+		parent := ht.trackedHead(earliest.Number - 1)
+		outOfRange := len(ht.chain) == 0 || earliest.Number <= ht.chain[0].Number
+		ht.headMutex.RUnlock()
+
+		if parent != nil && parent.Hash == earliest.ParentHash {
+			ancestor := *parent
+			return &ancestor, nil
+		} else if outOfRange || earliest.ParentHash == (common.Hash{}) {
+			break
+		}
+
+		block, err := ht.store.TxManager.GetBlockByNumber(hexutil.EncodeBig(big.NewInt(earliest.Number - 1)))
+		if err != nil {
+			return nil, errors.Wrap(err, "HeadTracker#findCommonAncestor GetBlockByNumber")
+		}
+		fetched := models.NewHeadFromBlockHeader(block)
+		if fetched == nil || fetched.Hash != earliest.ParentHash {
+			return nil, fmt.Errorf("block #%d does not match parent hash %s of block %s, chain changed while walking back", earliest.Number-1, earliest.ParentHash.Hex(), earliest.Hash.Hex())
+		}
+		*newHeads = append([]models.Head{*fetched}, *newHeads...)
+	}
+
+	logger.Errorw(
+		"No common ancestor found within the tracked heads, treating all of them as orphaned",
+		"blockHeight", (*newHeads)[len(*newHeads)-1].ToInt(),
+	)
+	return nil, nil
+}
+
 type errBlockNotLater struct {
 	message string
 }
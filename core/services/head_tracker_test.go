@@ -218,3 +218,96 @@ func TestHeadTracker_StartConnectsFromLastSavedHeader(t *testing.T) {
 	g.Eventually(func() *big.Int { return ht.Head().ToInt() }).Should(gomega.Equal(currentBN))
 	assert.NoError(t, ht.Stop())
 }
+
+func TestHeadTracker_ReorgDetection(t *testing.T) {
+	t.Parallel()
+	g := gomega.NewGomegaWithT(t)
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	mocketh := cltest.MockEthOnStore(t, store)
+	headers := make(chan eth.BlockHeader)
+	mocketh.RegisterSubscription("newHeads", headers)
+	mocketh.Register("eth_chainId", store.Config.ChainID())
+
+	var reorgAncestor *models.Head
+	var reorgOldHeads, reorgNewHeads []models.Head
+	checker := &cltest.MockHeadTrackable{ReorgCallback: func(ancestor *models.Head, oldHeads, newHeads []models.Head) {
+		reorgAncestor, reorgOldHeads, reorgNewHeads = ancestor, oldHeads, newHeads
+	}}
+	ht := services.NewHeadTracker(store, []strpkg.HeadTrackable{checker}, cltest.NeverSleeper{})
+
+	h1 := models.NewHead(big.NewInt(1), cltest.NewHash())
+	h2 := models.NewHead(big.NewInt(2), cltest.NewHash())
+	h2.ParentHash = h1.Hash
+	h3 := models.NewHead(big.NewInt(3), cltest.NewHash())
+	h3.ParentHash = h2.Hash
+	for _, h := range []*models.Head{h1, h2, h3} {
+		require.NoError(t, ht.Save(h))
+	}
+
+	forkedH3 := eth.BlockHeader{Number: cltest.BigHexInt(3), ParentHash: h2.Hash, GethHash: cltest.NewHash()}
+	forkedH4 := eth.BlockHeader{Number: cltest.BigHexInt(4), ParentHash: forkedH3.GethHash, GethHash: cltest.NewHash()}
+	mocketh.Register("eth_getBlockByNumber", forkedH3)
+
+	require.NoError(t, ht.Start())
+	g.Eventually(func() int32 { return checker.ConnectedCount() }).Should(gomega.Equal(int32(1)))
+
+	headers <- forkedH4
+	g.Eventually(func() int32 { return checker.OnReorgCount() }).Should(gomega.Equal(int32(1)))
+	g.Eventually(func() int32 { return checker.OnNewHeadCount() }).Should(gomega.Equal(int32(1)))
+	require.NoError(t, ht.Stop())
+
+	require.NotNil(t, reorgAncestor)
+	assert.Equal(t, h2.Hash, reorgAncestor.Hash)
+	require.Len(t, reorgOldHeads, 1)
+	assert.Equal(t, h3.Hash, reorgOldHeads[0].Hash)
+	require.Len(t, reorgNewHeads, 2)
+	assert.Equal(t, forkedH3.GethHash, reorgNewHeads[0].Hash)
+	assert.Equal(t, forkedH4.GethHash, reorgNewHeads[1].Hash)
+
+	assert.Equal(t, forkedH4.GethHash, ht.Head().Hash)
+	lastHead, err := store.LastHead()
+	require.NoError(t, err)
+	assert.Equal(t, forkedH4.GethHash, lastHead.Hash)
+
+	heads, err := store.LastHeads(models.HeadsRetained)
+	require.NoError(t, err)
+	require.Len(t, heads, 4)
+	assert.Equal(t, forkedH3.GethHash, heads[2].Hash)
+	mocketh.EventuallyAllCalled(t)
+}
+
+func TestHeadTracker_MissedHeadsAreNotReorgs(t *testing.T) {
+	t.Parallel()
+	g := gomega.NewGomegaWithT(t)
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	mocketh := cltest.MockEthOnStore(t, store)
+	headers := make(chan eth.BlockHeader)
+	mocketh.RegisterSubscription("newHeads", headers)
+	mocketh.Register("eth_chainId", store.Config.ChainID())
+
+	checker := &cltest.MockHeadTrackable{}
+	ht := services.NewHeadTracker(store, []strpkg.HeadTrackable{checker}, cltest.NeverSleeper{})
+
+	h1 := models.NewHead(big.NewInt(1), cltest.NewHash())
+	require.NoError(t, ht.Save(h1))
+
+	missedH2 := eth.BlockHeader{Number: cltest.BigHexInt(2), ParentHash: h1.Hash, GethHash: cltest.NewHash()}
+	h3 := eth.BlockHeader{Number: cltest.BigHexInt(3), ParentHash: missedH2.GethHash, GethHash: cltest.NewHash()}
+	mocketh.Register("eth_getBlockByNumber", missedH2)
+
+	require.NoError(t, ht.Start())
+	g.Eventually(func() int32 { return checker.ConnectedCount() }).Should(gomega.Equal(int32(1)))
+
+	headers <- h3
+	g.Eventually(func() int32 { return checker.OnNewHeadCount() }).Should(gomega.Equal(int32(1)))
+	require.NoError(t, ht.Stop())
+
+	assert.Equal(t, int32(0), checker.OnReorgCount())
+	assert.Equal(t, h3.GethHash, ht.Head().Hash)
+	assert.Len(t, ht.Chain(), 3)
+	mocketh.EventuallyAllCalled(t)
+}
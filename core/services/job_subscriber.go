@@ -39,6 +39,7 @@ type jobSubscriber struct {
 	jobSubscriptions          map[string]JobSubscription
 	jobsMutex                 *sync.RWMutex
 	runManager                RunManager
+	logBroadcaster            *LogBroadcaster
 	jobResumer                SleeperTask
 	resumeRunsOnNewHeadWorker *resumeRunsOnNewHeadWorker
 }
@@ -57,12 +58,14 @@ func (rw *resumeRunsOnNewHeadWorker) Work() {
 	logger.Debugw("Finished work")
 }
 
-// NewJobSubscriber returns a new job subscriber.
-func NewJobSubscriber(store *store.Store, runManager RunManager) JobSubscriber {
+// NewJobSubscriber returns a new job subscriber, subscribing to the logs of
+// jobs through the log broadcaster.
+func NewJobSubscriber(store *store.Store, runManager RunManager, logBroadcaster *LogBroadcaster) JobSubscriber {
 	rw := &resumeRunsOnNewHeadWorker{runManager: runManager}
 	js := &jobSubscriber{
 		store:                     store,
 		runManager:                runManager,
+		logBroadcaster:            logBroadcaster,
 		jobSubscriptions:          map[string]JobSubscription{},
 		jobsMutex:                 &sync.RWMutex{},
 		jobResumer:                NewSleeperTask(rw),
@@ -83,7 +86,7 @@ func (js *jobSubscriber) AddJob(job models.JobSpec, bn *models.Head) error {
 		return nil
 	}
 
-	sub, err := StartJobSubscription(job, bn, js.store, js.runManager)
+	sub, err := StartJobSubscription(job, bn, js.store, js.runManager, js.logBroadcaster)
 	if err != nil {
 		return err
 	}
@@ -148,6 +151,15 @@ func (js *jobSubscriber) Disconnect() {
 	js.jobSubscriptions = map[string]JobSubscription{}
 }
 
+// OnReorg errors all unfinished runs whose initiating log was in one of the
+// orphaned blocks. Logs that were mined again on the new chain are
+// redelivered by the subscriptions and trigger new runs.
+func (js *jobSubscriber) OnReorg(ancestor *models.Head, oldHeads, newHeads []models.Head) {
+	if err := js.runManager.InvalidateOrphanedRuns(oldHeads); err != nil {
+		logger.Errorw("Failed to invalidate runs orphaned by chain reorganization", "error", err)
+	}
+}
+
 // OnNewHead resumes all pending job runs based on the new head activity.
 func (js *jobSubscriber) OnNewHead(head *models.Head) {
 	js.resumeRunsOnNewHeadWorker.head = *head.ToInt()
@@ -23,7 +23,7 @@ func TestJobSubscriber_OnNewHead(t *testing.T) {
 	defer cleanup()
 
 	runManager := new(mocks.RunManager)
-	jobSubscriber := services.NewJobSubscriber(store, runManager)
+	jobSubscriber := services.NewJobSubscriber(store, runManager, services.NewLogBroadcaster(store.TxManager))
 
 	wg := sync.WaitGroup{}
 	wg.Add(1)
@@ -83,7 +83,7 @@ func TestJobSubscriber_AddJob_RemoveJob(t *testing.T) {
 	cltest.MockEthOnStore(t, store)
 
 	runManager := new(mocks.RunManager)
-	jobSubscriber := services.NewJobSubscriber(store, runManager)
+	jobSubscriber := services.NewJobSubscriber(store, runManager, services.NewLogBroadcaster(store.TxManager))
 
 	jobSpec := cltest.NewJobWithLogInitiator()
 	err := jobSubscriber.AddJob(jobSpec, cltest.Head(321))
@@ -106,7 +106,7 @@ func TestJobSubscriber_AddJob_NotLogInitiatedError(t *testing.T) {
 	defer cleanup()
 
 	runManager := new(mocks.RunManager)
-	jobSubscriber := services.NewJobSubscriber(store, runManager)
+	jobSubscriber := services.NewJobSubscriber(store, runManager, services.NewLogBroadcaster(store.TxManager))
 
 	job := models.JobSpec{}
 	err := jobSubscriber.AddJob(job, cltest.Head(1))
@@ -120,7 +120,7 @@ func TestJobSubscriber_RemoveJob_NotFoundError(t *testing.T) {
 	defer cleanup()
 
 	runManager := new(mocks.RunManager)
-	jobSubscriber := services.NewJobSubscriber(store, runManager)
+	jobSubscriber := services.NewJobSubscriber(store, runManager, services.NewLogBroadcaster(store.TxManager))
 
 	err := jobSubscriber.RemoveJob(models.NewID())
 	require.Error(t, err)
@@ -133,7 +133,7 @@ func TestJobSubscriber_Connect_Disconnect(t *testing.T) {
 	defer cleanup()
 
 	runManager := new(mocks.RunManager)
-	jobSubscriber := services.NewJobSubscriber(store, runManager)
+	jobSubscriber := services.NewJobSubscriber(store, runManager, services.NewLogBroadcaster(store.TxManager))
 
 	eth := cltest.MockEthOnStore(t, store)
 	eth.Register("eth_getLogs", []ethpkg.Log{})
@@ -0,0 +1,471 @@
+package services
+
+import (
+	"bytes"
+	"reflect"
+	"sort"
+	"sync"
+
+	"chainlink/core/eth"
+	"chainlink/core/logger"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	numberLogBroadcasterSubscriptions = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "log_broadcaster_subscriptions",
+		Help: "The number of log subscriptions to the ethereum node shared by the log broadcaster",
+	})
+	numberLogBroadcasterListeners = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "log_broadcaster_listeners",
+		Help: "The number of log subscriptions made through the log broadcaster",
+	})
+)
+
+// LogBroadcaster holds a single log subscription to the ethereum node per
+// contract address, instead of one per initiator. The log subscriptions made
+// through it, by the JobSubscriber and the FluxMonitor, register listeners
+// for the address of their filter. The filters of all the listeners for an
+// address are merged into the filter of its shared subscription, and each
+// log received is broadcast to the listeners whose own filter it matches.
+//
+// Filters without an address share a subscription to the logs of every
+// contract.
+type LogBroadcaster struct {
+	logSubscriber eth.LogSubscriber
+	mutex         sync.Mutex
+	addresses     map[common.Address]*addressListeners
+	listeners     int
+}
+
+// addressListeners are the listeners registered for the logs of an address,
+// and the subscription shared between them.
+type addressListeners struct {
+	address      common.Address
+	listeners    map[*logListener]struct{}
+	subscription *sharedSubscription
+}
+
+// sharedSubscription is a log subscription to the ethereum node, with the
+// merged filter of the listeners for its address.
+type sharedSubscription struct {
+	filter       ethereum.FilterQuery
+	subscription eth.Subscription
+	logs         chan eth.Log
+	done         chan struct{}
+}
+
+// NewLogBroadcaster returns a LogBroadcaster subscribing to the ethereum node
+// through logSubscriber.
+func NewLogBroadcaster(logSubscriber eth.LogSubscriber) *LogBroadcaster {
+	return &LogBroadcaster{
+		logSubscriber: logSubscriber,
+		addresses:     map[common.Address]*addressListeners{},
+	}
+}
+
+// GetLogs returns the logs matching the filter query, which are retrieved
+// directly from the ethereum node for each backfill.
+func (lb *LogBroadcaster) GetLogs(q ethereum.FilterQuery) ([]eth.Log, error) {
+	return lb.logSubscriber.GetLogs(q)
+}
+
+// GetLogsBatch returns the logs matching each of the filter queries, which
+// are retrieved directly from the ethereum node in a single batch request.
+func (lb *LogBroadcaster) GetLogsBatch(qs []ethereum.FilterQuery) ([][]eth.Log, error) {
+	return lb.logSubscriber.GetLogsBatch(qs)
+}
+
+// SubscribeToLogs registers a listener sending the logs that match the
+// filter query to channel. The subscription to the address of the query is
+// made if it does not exist yet, or remade with a merged filter if the query
+// is not already covered by its filter.
+func (lb *LogBroadcaster) SubscribeToLogs(channel chan<- eth.Log, q ethereum.FilterQuery) (eth.Subscription, error) {
+	listener := &logListener{
+		broadcaster: lb,
+		filter:      q,
+		channel:     channel,
+		errors:      make(chan error, 1),
+		notify:      make(chan struct{}, 1),
+		done:        make(chan struct{}),
+	}
+
+	lb.mutex.Lock()
+	var stale []*sharedSubscription
+	var err error
+	for _, address := range listenerAddresses(q) {
+		al, ok := lb.addresses[address]
+		if !ok {
+			al = &addressListeners{address: address, listeners: map[*logListener]struct{}{}}
+			lb.addresses[address] = al
+		}
+		al.listeners[listener] = struct{}{}
+
+		var old *sharedSubscription
+		old, err = lb.resubscribe(al)
+		if old != nil {
+			stale = append(stale, old)
+		}
+		if err != nil {
+			break
+		}
+	}
+	if err != nil {
+		stale = append(stale, lb.unregister(listener)...)
+	} else {
+		lb.listeners++
+		numberLogBroadcasterListeners.Set(float64(lb.listeners))
+	}
+	lb.mutex.Unlock()
+
+	stopSharedSubscriptions(stale)
+	if err != nil {
+		return nil, err
+	}
+
+	go listener.forward()
+	return listener, nil
+}
+
+// unsubscribe deregisters the listener, unsubscribing from the addresses no
+// longer listened to.
+func (lb *LogBroadcaster) unsubscribe(listener *logListener) {
+	lb.mutex.Lock()
+	stale := lb.unregister(listener)
+	lb.listeners--
+	numberLogBroadcasterListeners.Set(float64(lb.listeners))
+	lb.mutex.Unlock()
+
+	stopSharedSubscriptions(stale)
+}
+
+// unregister removes the listener from each of its addresses, returning the
+// shared subscriptions to stop. Must be called with the mutex held.
+func (lb *LogBroadcaster) unregister(listener *logListener) []*sharedSubscription {
+	var stale []*sharedSubscription
+	for _, address := range listenerAddresses(listener.filter) {
+		al, ok := lb.addresses[address]
+		if !ok {
+			continue
+		}
+		if _, ok := al.listeners[listener]; !ok {
+			continue
+		}
+		delete(al.listeners, listener)
+
+		old, err := lb.resubscribe(al)
+		if err != nil {
+			logger.Warnw("Unable to narrow log subscription", "address", address.Hex(), "error", err)
+		}
+		if old != nil {
+			stale = append(stale, old)
+		}
+	}
+	return stale
+}
+
+// resubscribe makes the shared subscription for the address match the merged
+// filter of its listeners, returning the replaced subscription, if any, to be
+// stopped once the mutex is released. The new subscription is made before the
+// old one is stopped so that no logs are missed in between. Must be called
+// with the mutex held.
+func (lb *LogBroadcaster) resubscribe(al *addressListeners) (*sharedSubscription, error) {
+	old := al.subscription
+	if len(al.listeners) == 0 {
+		delete(lb.addresses, al.address)
+		if old != nil {
+			numberLogBroadcasterSubscriptions.Dec()
+		}
+		return old, nil
+	}
+
+	filter := mergeFilters(al.address, al.listeners)
+	if old != nil && reflect.DeepEqual(old.filter, filter) {
+		return nil, nil
+	}
+
+	shared := &sharedSubscription{
+		filter: filter,
+		logs:   make(chan eth.Log),
+		done:   make(chan struct{}),
+	}
+	subscription, err := lb.logSubscriber.SubscribeToLogs(shared.logs, filter)
+	if err != nil {
+		return nil, err
+	}
+	shared.subscription = subscription
+	al.subscription = shared
+	if old == nil {
+		numberLogBroadcasterSubscriptions.Inc()
+	}
+	go lb.broadcast(al, shared)
+
+	logger.Debugw("Subscribed to logs", "address", al.address.Hex(), "listeners", len(al.listeners))
+	return old, nil
+}
+
+// broadcast sends each log received on the shared subscription to the
+// listeners whose filter it matches, until the subscription is stopped or
+// fails.
+func (lb *LogBroadcaster) broadcast(al *addressListeners, shared *sharedSubscription) {
+	for {
+		select {
+		case <-shared.done:
+			return
+		case log := <-shared.logs:
+			for _, listener := range lb.listenersFor(al, shared) {
+				if filterMatches(listener.filter, log) {
+					listener.deliver(log)
+				}
+			}
+		case err := <-shared.subscription.Err():
+			// The error channel is closed when a stopped subscription is
+			// unsubscribed.
+			select {
+			case <-shared.done:
+				return
+			default:
+			}
+			lb.fail(al, shared, err)
+			return
+		}
+	}
+}
+
+// listenersFor returns the listeners of the address, if shared is still its
+// subscription.
+func (lb *LogBroadcaster) listenersFor(al *addressListeners, shared *sharedSubscription) []*logListener {
+	lb.mutex.Lock()
+	defer lb.mutex.Unlock()
+	if al.subscription != shared {
+		return nil
+	}
+
+	listeners := make([]*logListener, 0, len(al.listeners))
+	for listener := range al.listeners {
+		listeners = append(listeners, listener)
+	}
+	return listeners
+}
+
+// fail drops the failed subscription to the address and passes the error on
+// to its listeners, which subscribe again.
+func (lb *LogBroadcaster) fail(al *addressListeners, shared *sharedSubscription, err error) {
+	lb.mutex.Lock()
+	if al.subscription != shared {
+		lb.mutex.Unlock()
+		return
+	}
+	if lb.addresses[al.address] == al {
+		delete(lb.addresses, al.address)
+		numberLogBroadcasterSubscriptions.Dec()
+	}
+	listeners := make([]*logListener, 0, len(al.listeners))
+	for listener := range al.listeners {
+		listeners = append(listeners, listener)
+	}
+	lb.mutex.Unlock()
+
+	logger.Warnw("Shared log subscription failed", "address", al.address.Hex(), "listeners", len(listeners), "error", err)
+	for _, listener := range listeners {
+		listener.fail(err)
+	}
+}
+
+func stopSharedSubscriptions(shared []*sharedSubscription) {
+	for _, s := range shared {
+		close(s.done)
+		timedUnsubscribe(s.subscription)
+	}
+}
+
+// logListener is a log subscription made through the LogBroadcaster. Logs are
+// queued for it so that a listener busy handling a log does not hold up the
+// other listeners of the address.
+type logListener struct {
+	broadcaster     *LogBroadcaster
+	filter          ethereum.FilterQuery
+	channel         chan<- eth.Log
+	errors          chan error
+	mutex           sync.Mutex
+	pending         []eth.Log
+	notify          chan struct{}
+	done            chan struct{}
+	unsubscribed    bool
+	unsubscribeOnce sync.Once
+}
+
+// Err returns a channel that receives an error if the shared subscription
+// fails, and is closed on Unsubscribe.
+func (l *logListener) Err() <-chan error {
+	return l.errors
+}
+
+// Unsubscribe deregisters the listener from the LogBroadcaster.
+func (l *logListener) Unsubscribe() {
+	l.unsubscribeOnce.Do(func() {
+		l.broadcaster.unsubscribe(l)
+
+		l.mutex.Lock()
+		defer l.mutex.Unlock()
+		l.unsubscribed = true
+		close(l.done)
+		close(l.errors)
+	})
+}
+
+func (l *logListener) deliver(log eth.Log) {
+	l.mutex.Lock()
+	l.pending = append(l.pending, log)
+	l.mutex.Unlock()
+
+	select {
+	case l.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (l *logListener) fail(err error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if l.unsubscribed {
+		return
+	}
+	select {
+	case l.errors <- err:
+	default:
+	}
+}
+
+// forward sends the queued logs to the listener's channel in the order they
+// were received.
+func (l *logListener) forward() {
+	for {
+		select {
+		case <-l.done:
+			return
+		case <-l.notify:
+		}
+
+		l.mutex.Lock()
+		logs := l.pending
+		l.pending = nil
+		l.mutex.Unlock()
+
+		for _, log := range logs {
+			select {
+			case <-l.done:
+				return
+			case l.channel <- log:
+			}
+		}
+	}
+}
+
+// listenerAddresses returns the addresses whose subscriptions a listener with
+// the filter query registers for, the zero address standing for every
+// contract.
+func listenerAddresses(q ethereum.FilterQuery) []common.Address {
+	if len(q.Addresses) == 0 {
+		return []common.Address{{}}
+	}
+	return q.Addresses
+}
+
+// mergeFilters returns a filter query for the logs of the address matching
+// the filter of any of the listeners. A topic position is only filtered on if
+// every listener filters on it, in which case any of their topics matches.
+func mergeFilters(address common.Address, listeners map[*logListener]struct{}) ethereum.FilterQuery {
+	var q ethereum.FilterQuery
+	if address != (common.Address{}) {
+		q.Addresses = []common.Address{address}
+	}
+
+	positions := -1
+	for listener := range listeners {
+		if positions == -1 || len(listener.filter.Topics) < positions {
+			positions = len(listener.filter.Topics)
+		}
+	}
+
+	for i := 0; i < positions; i++ {
+		seen := map[common.Hash]bool{}
+		var topics []common.Hash
+		for listener := range listeners {
+			if len(listener.filter.Topics[i]) == 0 {
+				topics = nil
+				break
+			}
+			for _, topic := range listener.filter.Topics[i] {
+				if !seen[topic] {
+					seen[topic] = true
+					topics = append(topics, topic)
+				}
+			}
+		}
+		sort.Slice(topics, func(a, b int) bool {
+			return bytes.Compare(topics[a].Bytes(), topics[b].Bytes()) < 0
+		})
+		q.Topics = append(q.Topics, topics)
+	}
+
+	// Trailing positions that are not filtered on are left out.
+	for len(q.Topics) > 0 && len(q.Topics[len(q.Topics)-1]) == 0 {
+		q.Topics = q.Topics[:len(q.Topics)-1]
+	}
+	return q
+}
+
+// filterMatches returns true if the log matches the filter query, in the same
+// way as the ethereum node filters the logs of a subscription.
+func filterMatches(q ethereum.FilterQuery, log eth.Log) bool {
+	if q.FromBlock != nil && q.FromBlock.Sign() >= 0 && log.BlockNumber < q.FromBlock.Uint64() {
+		return false
+	}
+	if q.ToBlock != nil && q.ToBlock.Sign() >= 0 && log.BlockNumber > q.ToBlock.Uint64() {
+		return false
+	}
+
+	if len(q.Addresses) > 0 {
+		found := false
+		for _, address := range q.Addresses {
+			found = found || address == log.Address
+		}
+		if !found {
+			return false
+		}
+	}
+
+	for i, topics := range q.Topics {
+		if len(topics) == 0 {
+			continue
+		}
+		if i >= len(log.Topics) {
+			return false
+		}
+		found := false
+		for _, topic := range topics {
+			found = found || topic == log.Topics[i]
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// logBroadcastingClient is an eth.Client whose log subscriptions are made
+// through a LogBroadcaster.
+type logBroadcastingClient struct {
+	eth.Client
+	logBroadcaster *LogBroadcaster
+}
+
+// SubscribeToLogs subscribes to logs through the LogBroadcaster.
+func (client logBroadcastingClient) SubscribeToLogs(channel chan<- eth.Log, q ethereum.FilterQuery) (eth.Subscription, error) {
+	return client.logBroadcaster.SubscribeToLogs(channel, q)
+}
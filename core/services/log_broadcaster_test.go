@@ -0,0 +1,118 @@
+package services_test
+
+import (
+	"errors"
+	"testing"
+
+	"chainlink/core/eth"
+	"chainlink/core/internal/cltest"
+	"chainlink/core/internal/mocks"
+	"chainlink/core/services"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogBroadcaster_SharesSubscriptionPerAddress(t *testing.T) {
+	t.Parallel()
+
+	address := cltest.NewAddress()
+	topicA := common.HexToHash("0x01")
+	topicB := common.HexToHash("0x02")
+	filterA := ethereum.FilterQuery{Addresses: []common.Address{address}, Topics: [][]common.Hash{{topicA}}}
+	filterB := ethereum.FilterQuery{Addresses: []common.Address{address}, Topics: [][]common.Hash{{topicB}}}
+	merged := ethereum.FilterQuery{Addresses: []common.Address{address}, Topics: [][]common.Hash{{topicA, topicB}}}
+
+	client := new(mocks.Client)
+	var shared chan<- eth.Log
+	captureChannel := func(args mock.Arguments) { shared = args.Get(0).(chan<- eth.Log) }
+	firstSub := cltest.EmptyMockSubscription()
+	client.On("SubscribeToLogs", mock.Anything, filterA).Return(firstSub, nil).Once()
+	mergedSub := cltest.EmptyMockSubscription()
+	client.On("SubscribeToLogs", mock.Anything, merged).Return(mergedSub, nil).Once().Run(captureChannel)
+	lastSub := cltest.EmptyMockSubscription()
+	client.On("SubscribeToLogs", mock.Anything, filterA).Return(lastSub, nil).Once().Run(captureChannel)
+
+	lb := services.NewLogBroadcaster(client)
+
+	logsA := make(chan eth.Log, 1)
+	subA, err := lb.SubscribeToLogs(logsA, filterA)
+	require.NoError(t, err)
+	logsB := make(chan eth.Log, 1)
+	subB, err := lb.SubscribeToLogs(logsB, filterB)
+	require.NoError(t, err)
+
+	// Each log is only sent to the listeners whose filter it matches
+	shared <- eth.Log{Address: address, Topics: []common.Hash{topicB}, BlockNumber: 1}
+	cltest.CallbackOrTimeout(t, "log with topic B received", func() {
+		assert.Equal(t, topicB, (<-logsB).Topics[0])
+	})
+	shared <- eth.Log{Address: address, Topics: []common.Hash{topicA}, BlockNumber: 2}
+	cltest.CallbackOrTimeout(t, "log with topic A received", func() {
+		assert.Equal(t, topicA, (<-logsA).Topics[0])
+	})
+	assert.Len(t, logsB, 0)
+
+	// The subscription is narrowed when a listener unsubscribes
+	subB.Unsubscribe()
+	shared <- eth.Log{Address: address, Topics: []common.Hash{topicA}, BlockNumber: 3}
+	cltest.CallbackOrTimeout(t, "log received after narrowing", func() {
+		assert.Equal(t, uint64(3), (<-logsA).BlockNumber)
+	})
+
+	subA.Unsubscribe()
+	_, open := <-lastSub.Errors
+	assert.False(t, open, "shared subscription should be unsubscribed")
+	client.AssertExpectations(t)
+}
+
+func TestLogBroadcaster_SubscribesPerAddress(t *testing.T) {
+	t.Parallel()
+
+	addressA := cltest.NewAddress()
+	addressB := cltest.NewAddress()
+	filterA := ethereum.FilterQuery{Addresses: []common.Address{addressA}}
+	filterB := ethereum.FilterQuery{Addresses: []common.Address{addressB}}
+
+	client := new(mocks.Client)
+	client.On("SubscribeToLogs", mock.Anything, filterA).Return(cltest.EmptyMockSubscription(), nil).Once()
+	client.On("SubscribeToLogs", mock.Anything, filterB).Return(cltest.EmptyMockSubscription(), nil).Once()
+
+	lb := services.NewLogBroadcaster(client)
+	for _, filter := range []ethereum.FilterQuery{filterA, filterB, filterA, filterB} {
+		sub, err := lb.SubscribeToLogs(make(chan eth.Log), filter)
+		require.NoError(t, err)
+		defer sub.Unsubscribe()
+	}
+
+	client.AssertExpectations(t)
+}
+
+func TestLogBroadcaster_SubscriptionErrorsArePassedOn(t *testing.T) {
+	t.Parallel()
+
+	filter := ethereum.FilterQuery{Addresses: []common.Address{cltest.NewAddress()}}
+	sharedSub := cltest.EmptyMockSubscription()
+	client := new(mocks.Client)
+	client.On("SubscribeToLogs", mock.Anything, filter).Return(sharedSub, nil).Once()
+
+	lb := services.NewLogBroadcaster(client)
+	first, err := lb.SubscribeToLogs(make(chan eth.Log), filter)
+	require.NoError(t, err)
+	defer first.Unsubscribe()
+	second, err := lb.SubscribeToLogs(make(chan eth.Log), filter)
+	require.NoError(t, err)
+	defer second.Unsubscribe()
+
+	sharedSub.Errors <- errors.New("connection lost")
+	for _, sub := range []eth.Subscription{first, second} {
+		cltest.CallbackOrTimeout(t, "error passed on to listener", func() {
+			assert.EqualError(t, <-sub.Err(), "connection lost")
+		})
+	}
+
+	client.AssertExpectations(t)
+}
@@ -0,0 +1,57 @@
+package services
+
+import (
+	"sync"
+	"time"
+
+	"chainlink/core/logger"
+)
+
+// retryResumerInterval is how often runs waiting to retry a failed task are
+// checked for whether their backoff has elapsed.
+const retryResumerInterval = time.Second
+
+// retryResumer periodically resumes the runs whose failed task is due to be
+// retried.
+type retryResumer struct {
+	runManager RunManager
+	done       chan struct{}
+	wg         sync.WaitGroup
+}
+
+func newRetryResumer(runManager RunManager) *retryResumer {
+	return &retryResumer{
+		runManager: runManager,
+		done:       make(chan struct{}),
+	}
+}
+
+// Start begins checking for runs to retry in the background.
+func (r *retryResumer) Start() error {
+	r.wg.Add(1)
+	go r.loop()
+	return nil
+}
+
+// Stop stops checking for runs to retry, and waits for any check in
+// progress to finish.
+func (r *retryResumer) Stop() {
+	close(r.done)
+	r.wg.Wait()
+}
+
+func (r *retryResumer) loop() {
+	defer r.wg.Done()
+
+	ticker := time.NewTicker(retryResumerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			logger.ErrorIf(r.runManager.ResumeAllRetrying(), "Unable to resume runs pending retry")
+		case <-r.done:
+			return
+		}
+	}
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"fmt"
+	"sync"
 	"time"
 
 	"chainlink/core/adapters"
@@ -11,6 +12,15 @@ import (
 	"chainlink/core/store/orm"
 
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	numberTaskRetries = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "run_executor_task_retries",
+		Help: "The total number of failed tasks scheduled to be retried",
+	})
 )
 
 //go:generate mockery -name RunExecutor -output ../internal/mocks/ -case=underscore
@@ -38,6 +48,10 @@ func (je *runExecutor) Execute(runID *models.ID) error {
 		return errors.Wrapf(err, "error finding run %s", runID)
 	}
 
+	if run.IsTaskGraph() {
+		return je.executeGraph(&run)
+	}
+
 	for taskIndex := range run.TaskRuns {
 		taskRun := &run.TaskRuns[taskIndex]
 		if !run.Status.Runnable() {
@@ -54,8 +68,11 @@ func (je *runExecutor) Execute(runID *models.ID) error {
 
 			result := je.executeTask(&run, taskRun)
 
-			taskRun.ApplyOutput(result)
-			run.ApplyOutput(result)
+			if je.applyTaskOutput(&run, taskRun, result) {
+				run.Status = models.RunStatusPendingRetry
+			} else {
+				run.ApplyOutput(result)
+			}
 
 			elapsed := time.Since(start).Seconds()
 
@@ -84,6 +101,140 @@ func (je *runExecutor) Execute(runID *models.ID) error {
 	return nil
 }
 
+// executeGraph performs the tasks of a run whose tasks form a graph. All of
+// the tasks whose inputs are complete are performed concurrently, until no
+// more tasks can be performed, each task being performed at most once.
+func (je *runExecutor) executeGraph(run *models.JobRun) error {
+	performed := make(map[string]bool)
+	for run.Status.Runnable() {
+		var batch []*models.TaskRun
+		pausedTasks := false
+		for _, taskRun := range run.ReadyTaskRuns() {
+			if performed[taskRun.ID.String()] {
+				continue
+			}
+			performed[taskRun.ID.String()] = true
+
+			if meetsMinimumConfirmations(run, taskRun, run.ObservedHeight) {
+				batch = append(batch, taskRun)
+			} else {
+				logger.Debugw("Pausing task pending confirmations",
+					run.ForLogger("task", taskRun.ID.String(), "required_height", taskRun.MinimumConfirmations)...,
+				)
+				taskRun.Status = models.RunStatusPendingConfirmations
+				pausedTasks = true
+			}
+		}
+		if len(batch) == 0 && !pausedTasks {
+			break
+		}
+
+		start := time.Now()
+		results := make([]models.RunOutput, len(batch))
+		var wg sync.WaitGroup
+		for i, taskRun := range batch {
+			wg.Add(1)
+			go func(i int, taskRun *models.TaskRun) {
+				defer wg.Done()
+				results[i] = je.executeTask(run, taskRun)
+			}(i, taskRun)
+		}
+		wg.Wait()
+		elapsed := time.Since(start).Seconds()
+
+		for i, taskRun := range batch {
+			// Assigned rather than appended to, as a paused task is performed
+			// again when its run resumes.
+			inputs := models.TaskInputs{}
+			for _, input := range run.TaskRunInputs(taskRun) {
+				inputs = append(inputs, input.ID.String())
+			}
+			taskRun.Inputs = inputs
+			je.applyTaskOutput(run, taskRun, results[i])
+			logger.Debugw(fmt.Sprintf("Executed task %s", taskRun.TaskSpec.Type), run.ForLogger("task", taskRun.ID.String(), "elapsed", elapsed)...)
+		}
+		updateGraphStatus(run)
+
+		if err := je.store.ORM.SaveJobRun(run); errors.Cause(err) == orm.OptimisticUpdateConflictError {
+			logger.Debugw("Optimistic update conflict while updating run", run.ForLogger()...)
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+
+	if run.Status.Finished() {
+		logger.Debugw("All tasks complete for run", run.ForLogger()...)
+	}
+	return nil
+}
+
+// updateGraphStatus sets the status of a run whose tasks form a graph from
+// the status of its tasks. The run errors as soon as one of its tasks does,
+// and is otherwise pending as long as any of its tasks are. Once all tasks
+// are complete, the result of the last task is the result of the run.
+func updateGraphStatus(run *models.JobRun) {
+	var pending *models.TaskRun
+	for i := range run.TaskRuns {
+		taskRun := &run.TaskRuns[i]
+		if taskRun.Status.Errored() {
+			run.SetError(errors.New(taskRun.Result.ErrorMessage.ValueOrZero()))
+			return
+		} else if pending == nil && taskRun.Status.Pending() {
+			pending = taskRun
+		}
+	}
+
+	if pending != nil {
+		run.Status = pending.Status
+	} else if run.TasksRemain() {
+		run.Status = models.RunStatusInProgress
+	} else if len(run.TaskRuns) > 0 {
+		last := run.TaskRuns[len(run.TaskRuns)-1]
+		run.ApplyOutput(models.NewRunOutputComplete(last.Result.Data))
+	}
+}
+
+// applyTaskOutput applies the result of performing a task to the task run,
+// unless the task failed and its retry policy allows retrying it, in which
+// case the task is scheduled to be retried and true is returned.
+func (je *runExecutor) applyTaskOutput(run *models.JobRun, taskRun *models.TaskRun, result models.RunOutput) bool {
+	if taskRun.TaskSpec.Retry != nil && (result.HasError() || result.Status().Completed()) {
+		taskRun.RecordAttempt(result.Error())
+	}
+
+	if je.shouldRetry(taskRun, result) {
+		je.scheduleRetry(run, taskRun, result.Error())
+		return true
+	}
+	taskRun.ApplyOutput(result)
+	return false
+}
+
+// shouldRetry returns true if the task failed with an error its retry policy
+// allows retrying after, and it has attempts left.
+func (je *runExecutor) shouldRetry(taskRun *models.TaskRun, result models.RunOutput) bool {
+	retry := taskRun.TaskSpec.Retry
+	if retry == nil || !result.HasError() {
+		return false
+	}
+	return retry.Retries(taskRun.Attempts, adapters.ErrorClasses(result.Error()))
+}
+
+// scheduleRetry pauses the task until its backoff has elapsed, rather than
+// waiting for it, so that the worker is freed up for other runs. The run is
+// resumed by RunManager#ResumeAllRetrying.
+func (je *runExecutor) scheduleRetry(run *models.JobRun, taskRun *models.TaskRun, err error) {
+	backoff := taskRun.TaskSpec.Retry.BackoffAfter(taskRun.Attempts)
+	taskRun.ScheduleRetry(je.store.Clock.Now().Add(backoff))
+
+	logger.Warnw(
+		fmt.Sprintf("Task %s failed, retrying in %v", taskRun.TaskSpec.Type, backoff),
+		run.ForLogger("task", taskRun.ID.String(), "attempts", taskRun.Attempts, "error", err)...,
+	)
+	numberTaskRetries.Inc()
+}
+
 func (je *runExecutor) executeTask(run *models.JobRun, taskRun *models.TaskRun) models.RunOutput {
 	taskCopy := taskRun.TaskSpec // deliberately copied to keep mutations local
 
@@ -98,14 +249,7 @@ func (je *runExecutor) executeTask(run *models.JobRun, taskRun *models.TaskRun)
 		return models.NewRunOutputError(err)
 	}
 
-	previousTaskRun := run.PreviousTaskRun()
-
-	previousTaskInput := models.JSON{}
-	if previousTaskRun != nil {
-		previousTaskInput = previousTaskRun.Result.Data
-	}
-
-	data, err := models.Merge(run.Overrides, previousTaskInput, taskRun.Result.Data)
+	data, err := taskInput(run, taskRun)
 	if err != nil {
 		return models.NewRunOutputError(err)
 	}
@@ -114,3 +258,29 @@ func (je *runExecutor) executeTask(run *models.JobRun, taskRun *models.TaskRun)
 	result := adapter.Perform(input, je.store)
 	return result
 }
+
+// taskInput returns the data passed to a task. That is the result of the
+// previous task, or for tasks of a graph the merged results of its inputs,
+// with the "result" of each input also collected in order under "results".
+func taskInput(run *models.JobRun, taskRun *models.TaskRun) (models.JSON, error) {
+	if !run.IsTaskGraph() {
+		previousTaskInput := models.JSON{}
+		if previousTaskRun := run.PreviousTaskRun(); previousTaskRun != nil {
+			previousTaskInput = previousTaskRun.Result.Data
+		}
+		return models.Merge(run.Overrides, previousTaskInput, taskRun.Result.Data)
+	}
+
+	inputs := run.TaskRunInputs(taskRun)
+	data := []models.JSON{run.Overrides}
+	results := []interface{}{}
+	for _, input := range inputs {
+		data = append(data, input.Result.Data)
+		results = append(results, input.Result.Data.Get("result").Value())
+	}
+	merged, err := models.Merge(append(data, taskRun.Result.Data)...)
+	if err != nil || len(inputs) == 0 {
+		return merged, err
+	}
+	return merged.Add("results", results)
+}
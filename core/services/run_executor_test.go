@@ -3,6 +3,8 @@ package services_test
 import (
 	"fmt"
 	"math/big"
+	"net/http"
+	"net/http/httptest"
 	"strconv"
 	"testing"
 	"time"
@@ -203,3 +205,169 @@ func TestJobRunner_prioritizeSpecParamsOverRequestParams(t *testing.T) {
 	expected := strconv.FormatUint(uint64(requestBase*specParameter), 10)
 	assert.Equal(t, expected, actual)
 }
+
+func TestRunExecutor_Execute_RetriesFailedTask(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	runExecutor := services.NewRunExecutor(store)
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprint(w, "try again later")
+			return
+		}
+		fmt.Fprint(w, "OK")
+	}))
+	defer server.Close()
+
+	j := models.NewJob()
+	j.Initiators = []models.Initiator{{Type: models.InitiatorWeb}}
+	task := cltest.NewTask(t, "httpget", fmt.Sprintf(`{"get": "%s"}`, server.URL))
+	task.Retry = &models.TaskRetry{MaxAttempts: 2, Backoff: models.Duration(time.Minute)}
+	j.Tasks = []models.TaskSpec{task}
+	require.NoError(t, store.CreateJob(&j))
+
+	run := cltest.NewJobRun(j)
+	require.NoError(t, store.CreateJobRun(&run))
+
+	require.NoError(t, runExecutor.Execute(run.ID))
+
+	run, err := store.FindJobRun(run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusPendingRetry, run.Status)
+	require.Len(t, run.TaskRuns, 1)
+	taskRun := run.TaskRuns[0]
+	assert.Equal(t, models.RunStatusPendingRetry, taskRun.Status)
+	assert.Equal(t, uint32(1), taskRun.Attempts)
+	assert.True(t, taskRun.RetryAt.Valid)
+	require.Len(t, taskRun.AttemptHistory, 1)
+	assert.Equal(t, "try again later", taskRun.AttemptHistory[0].Error.ValueOrZero())
+
+	run.Status = models.RunStatusInProgress
+	run.TaskRuns[0].Status = models.RunStatusInProgress
+	require.NoError(t, store.SaveJobRun(&run))
+	require.NoError(t, runExecutor.Execute(run.ID))
+
+	run, err = store.FindJobRun(run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusCompleted, run.Status)
+	taskRun = run.TaskRuns[0]
+	assert.Equal(t, uint32(2), taskRun.Attempts)
+	require.Len(t, taskRun.AttemptHistory, 2)
+	assert.False(t, taskRun.AttemptHistory[1].Error.Valid)
+	assert.Equal(t, 2, requests)
+}
+
+func TestRunExecutor_Execute_DoesNotRetryUnretryableErrors(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	runExecutor := services.NewRunExecutor(store)
+
+	server, assertCalled := cltest.NewHTTPMockServer(t, http.StatusBadRequest, "GET", "bad request")
+	defer assertCalled()
+
+	j := models.NewJob()
+	j.Initiators = []models.Initiator{{Type: models.InitiatorWeb}}
+	task := cltest.NewTask(t, "httpget", fmt.Sprintf(`{"get": "%s"}`, server.URL))
+	task.Retry = &models.TaskRetry{MaxAttempts: 3, RetryOn: []models.RetryableErrorClass{models.RetryOnHTTP5xx}}
+	j.Tasks = []models.TaskSpec{task}
+	require.NoError(t, store.CreateJob(&j))
+
+	run := cltest.NewJobRun(j)
+	require.NoError(t, store.CreateJobRun(&run))
+
+	require.NoError(t, runExecutor.Execute(run.ID))
+
+	run, err := store.FindJobRun(run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusErrored, run.Status)
+	assert.Equal(t, uint32(1), run.TaskRuns[0].Attempts)
+	assert.Equal(t, "bad request", run.ErrorString())
+}
+
+func TestRunExecutor_Execute_TaskGraph(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	runExecutor := services.NewRunExecutor(store)
+
+	first, assertFirstCalled := cltest.NewHTTPMockServer(t, http.StatusOK, "GET", "1")
+	defer assertFirstCalled()
+	second, assertSecondCalled := cltest.NewHTTPMockServer(t, http.StatusOK, "GET", "2")
+	defer assertSecondCalled()
+
+	j := models.NewJob()
+	j.Initiators = []models.Initiator{{Type: models.InitiatorWeb}}
+	firstTask := cltest.NewTask(t, "httpget", fmt.Sprintf(`{"get": "%s"}`, first.URL))
+	firstTask.TaskID = "first"
+	secondTask := cltest.NewTask(t, "httpget", fmt.Sprintf(`{"get": "%s"}`, second.URL))
+	secondTask.TaskID = "second"
+	combined := cltest.NewTask(t, "noop")
+	combined.TaskID = "combined"
+	combined.Inputs = models.TaskInputs{"first", "second"}
+	j.Tasks = []models.TaskSpec{firstTask, secondTask, combined}
+	require.NoError(t, store.CreateJob(&j))
+
+	run := cltest.NewJobRun(j)
+	require.NoError(t, store.CreateJobRun(&run))
+
+	require.NoError(t, runExecutor.Execute(run.ID))
+
+	run, err := store.FindJobRun(run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusCompleted, run.Status)
+	require.Len(t, run.TaskRuns, 3)
+	for _, tr := range run.TaskRuns {
+		assert.Equal(t, models.RunStatusCompleted, tr.Status)
+	}
+	assert.Empty(t, run.TaskRuns[0].Inputs)
+	assert.Equal(t, models.TaskInputs{run.TaskRuns[0].ID.String(), run.TaskRuns[1].ID.String()}, run.TaskRuns[2].Inputs)
+	assert.Equal(t, `["1","2"]`, run.Result.Data.Get("results").String())
+}
+
+func TestRunExecutor_Execute_TaskGraphResumesPendingTask(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	runExecutor := services.NewRunExecutor(store)
+
+	j := models.NewJob()
+	j.Initiators = []models.Initiator{{Type: models.InitiatorWeb}}
+	first := cltest.NewTask(t, "noop")
+	first.TaskID = "first"
+	pending := cltest.NewTask(t, "nooppend")
+	pending.TaskID = "pending"
+	pending.Inputs = models.TaskInputs{"first"}
+	j.Tasks = []models.TaskSpec{first, pending}
+	require.NoError(t, store.CreateJob(&j))
+
+	run := cltest.NewJobRun(j)
+	require.NoError(t, store.CreateJobRun(&run))
+
+	for i := 0; i < 2; i++ {
+		require.NoError(t, runExecutor.Execute(run.ID))
+
+		run, err := store.FindJobRun(run.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.RunStatusPendingConfirmations, run.Status)
+		require.Len(t, run.TaskRuns, 2)
+		assert.Equal(t, models.TaskInputs{run.TaskRuns[0].ID.String()}, run.TaskRuns[1].Inputs)
+
+		// Resume the run, performing the pending task again
+		run.Status = models.RunStatusInProgress
+		require.NoError(t, store.SaveJobRun(&run))
+	}
+}
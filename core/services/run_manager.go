@@ -7,6 +7,7 @@ import (
 
 	"chainlink/core/adapters"
 	"chainlink/core/assets"
+	"chainlink/core/eth"
 	"chainlink/core/logger"
 	clnull "chainlink/core/null"
 	"chainlink/core/store"
@@ -14,6 +15,7 @@ import (
 	"chainlink/core/store/orm"
 	"chainlink/core/utils"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -32,8 +34,35 @@ var (
 		Name: "run_manager_runs_cancelled",
 		Help: "The total number of run cancellations",
 	})
+	numberRunsOrphaned = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "run_manager_runs_orphaned",
+		Help: "The total number of runs errored because their request was in an orphaned block",
+	})
+	numberRunsRemoved = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "run_manager_runs_removed",
+		Help: "The total number of runs cancelled because the log that requested them was removed",
+	})
+	numberRunsRejected = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "run_manager_runs_rejected",
+		Help: "The total number of runs rejected because the run queue was saturated",
+	})
 )
 
+// unfinishedRunStatuses are the statuses of runs that have not yet finished,
+// and may still submit a transaction.
+var unfinishedRunStatuses = []models.RunStatus{
+	models.RunStatusInProgress,
+	models.RunStatusPendingConfirmations,
+	models.RunStatusPendingConnection,
+	models.RunStatusPendingBridge,
+	models.RunStatusPendingSleep,
+	models.RunStatusPendingRetry,
+}
+
+// ErrRunQueueSaturated is returned when a run cannot be created because too
+// many runs are already waiting to be executed.
+var ErrRunQueueSaturated = errors.New("run queue is saturated, try again later")
+
 // RecurringScheduleJobError contains the field for the error message.
 type RecurringScheduleJobError struct {
 	msg string
@@ -67,6 +96,10 @@ type RunManager interface {
 	ResumeAllInProgress() error
 	ResumeAllConfirming(currentBlockHeight *big.Int) error
 	ResumeAllConnecting() error
+	ResumeAllRetrying() error
+	InvalidateOrphanedRuns(orphanedHeads []models.Head) error
+	CancelRunsForRemovedLog(initiator models.Initiator, log eth.Log) error
+	CancelRunForCancelledTx(tx *models.Tx) error
 }
 
 // runManager implements RunManager
@@ -215,7 +248,8 @@ func (jm *runManager) CreateErrored(
 }
 
 // Create immediately persists a JobRun and sends it to the RunQueue for
-// execution.
+// execution. While the RunQueue is saturated, runs are rejected with
+// ErrRunQueueSaturated, except for log initiated runs which are deferred.
 func (jm *runManager) Create(
 	jobSpecID *models.ID,
 	initiator *models.Initiator,
@@ -228,6 +262,13 @@ func (jm *runManager) Create(
 		"creation_height", creationHeight.String(),
 	)
 
+	if run, err := jm.findRunForLog(runRequest); err == nil {
+		logger.Debugw("Run already created for log", run.ForLogger()...)
+		return run, nil
+	} else if err != orm.ErrorNotFound {
+		return nil, errors.Wrap(err, "failed to find run for log")
+	}
+
 	job, err := jm.orm.Unscoped().FindJob(jobSpecID)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to find job spec")
@@ -256,10 +297,25 @@ func (jm *runManager) Create(
 		return nil, fmt.Errorf("invariant for job %s: no tasks to run in NewRun", job.ID)
 	}
 
+	// Logs are not delivered again, so rather than being rejected, runs
+	// initiated by a log wait for room in the queue.
+	saturated := jm.runQueue.Saturated()
+	if saturated && !initiator.IsLogInitiated() {
+		logger.Warnw("Rejecting new run, run queue is saturated", "job", job.ID.String())
+		numberRunsRejected.Inc()
+		return nil, ErrRunQueueSaturated
+	}
+
 	run, adapters := NewRun(&job, initiator, data, creationHeight, runRequest, jm.config, jm.orm, now)
 	runCost := runCost(&job, jm.config, adapters)
 	ValidateRun(run, runCost)
 
+	if saturated && run.Status.Runnable() {
+		logger.Warnw("Run queue is saturated, deferring log initiated run", run.ForLogger()...)
+		run.NextTaskRun().ScheduleRetry(now)
+		run.Status = models.RunStatusPendingRetry
+	}
+
 	if err := jm.orm.CreateJobRun(run); err != nil {
 		return nil, errors.Wrap(err, "CreateJobRun failed")
 	}
@@ -275,6 +331,20 @@ func (jm *runManager) Create(
 	return run, nil
 }
 
+// findRunForLog returns the run already created for the log that triggered
+// the run request, if any, so that replaying or backfilling logs does not run
+// a job twice for the same log.
+func (jm *runManager) findRunForLog(rr *models.RunRequest) (*models.JobRun, error) {
+	if rr.InitiatorID == nil || rr.BlockHash == nil || rr.TxHash == nil || rr.LogIndex == nil {
+		return nil, orm.ErrorNotFound
+	}
+	run, err := jm.orm.Unscoped().FindJobRunForLog(*rr.InitiatorID, *rr.BlockHash, *rr.TxHash, *rr.LogIndex)
+	if err != nil {
+		return nil, err
+	}
+	return &run, nil
+}
+
 // ResumeAllConfirming wakes up all jobs that were sleeping because they were
 // waiting for block confirmations.
 func (jm *runManager) ResumeAllConfirming(currentBlockHeight *big.Int) error {
@@ -318,6 +388,35 @@ func (jm *runManager) ResumeAllConnecting() error {
 	}, models.RunStatusPendingConnection, models.RunStatusPendingConfirmations)
 }
 
+// ResumeAllRetrying wakes up all runs that were paused to retry a failed task,
+// once the task's backoff has elapsed and the run queue has room. Log
+// initiated runs deferred by a saturated run queue are resumed the same way.
+func (jm *runManager) ResumeAllRetrying() error {
+	now := jm.clock.Now()
+	return jm.orm.UnscopedJobRunsWithStatus(func(run *models.JobRun) {
+		currentTaskRun := run.NextTaskRun()
+		if currentTaskRun == nil {
+			jm.updateWithError(run, "Attempting to retry run with no remaining tasks %s", run.ID)
+			return
+		}
+
+		if currentTaskRun.RetryAt.Valid && currentTaskRun.RetryAt.Time.After(now) {
+			return
+		}
+		if jm.runQueue.Saturated() {
+			return
+		}
+
+		logger.Debugw("Retrying failed task", run.ForLogger("task", currentTaskRun.ID.String(), "attempts", currentTaskRun.Attempts)...)
+		currentTaskRun.Status = models.RunStatusInProgress
+		run.Status = models.RunStatusInProgress
+		err := jm.updateAndTrigger(run)
+		if err != nil {
+			logger.Errorw("Error saving run", run.ForLogger("error", err)...)
+		}
+	}, models.RunStatusPendingRetry)
+}
+
 // ResumePendingTask wakes up a task that required a response from a bridge adapter.
 func (jm *runManager) ResumePending(
 	runID *models.ID,
@@ -364,6 +463,113 @@ func (jm *runManager) ResumeAllInProgress() error {
 	return jm.orm.UnscopedJobRunsWithStatus(jm.runQueue.Run, models.RunStatusInProgress, models.RunStatusPendingSleep)
 }
 
+// InvalidateOrphanedRuns errors all unfinished runs whose initiating request
+// was included in one of the orphaned blocks, since the request is no longer
+// part of the main chain. If the request is mined again, its log is
+// redelivered and triggers a new run.
+func (jm *runManager) InvalidateOrphanedRuns(orphanedHeads []models.Head) error {
+	blockHashes := make([]common.Hash, len(orphanedHeads))
+	for i, head := range orphanedHeads {
+		blockHashes[i] = head.Hash
+	}
+
+	return jm.orm.UnscopedJobRunsWithStatusInBlocks(func(run *models.JobRun) {
+		logger.Warnw("Request for run was in an orphaned block", run.ForLogger("block_hash", run.RunRequest.BlockHash.Hex())...)
+		numberRunsOrphaned.Inc()
+		_ = jm.updateWithError(
+			run,
+			"Block %s containing the request for run %s was orphaned by a chain reorganization",
+			run.RunRequest.BlockHash.Hex(),
+			run.ID,
+		)
+	},
+		blockHashes,
+		unfinishedRunStatuses...,
+	)
+}
+
+// CancelRunsForRemovedLog cancels all unfinished runs of the initiator that
+// were requested by a log since removed from the chain by a reorganization,
+// so that they do not fulfil a request that no longer exists. Transactions
+// the runs sent that have not been mined are abandoned by replacing them.
+func (jm *runManager) CancelRunsForRemovedLog(initiator models.Initiator, log eth.Log) error {
+	return jm.orm.UnscopedJobRunsWithStatusForLog(func(run *models.JobRun) {
+		logger.Warnw("Log requesting run was removed", run.ForLogger("tx_hash", log.TxHash.Hex(), "block_hash", log.BlockHash.Hex())...)
+		numberRunsRemoved.Inc()
+		run.CancelWithReason(fmt.Sprintf(
+			"Log %d of transaction %s in block %s requesting run %s was removed by a chain reorganization",
+			log.Index,
+			log.TxHash.Hex(),
+			log.BlockHash.Hex(),
+			run.ID,
+		))
+		if err := jm.orm.SaveJobRun(run); err != nil {
+			logger.Errorw("Error saving run", run.ForLogger("error", err)...)
+			return
+		}
+		jm.abandonUnminedTx(run)
+	},
+		initiator.ID,
+		log.BlockHash,
+		log.TxHash,
+		unfinishedRunStatuses...,
+	)
+}
+
+// abandonUnminedTx cancels the transaction sent by the run, unless it has
+// already been mined. The run no longer bumps the transaction's gas, so the
+// self-send replacing it is bumped by the TxManager on new heads instead.
+func (jm *runManager) abandonUnminedTx(run *models.JobRun) {
+	tx, err := jm.orm.FindTxBySurrogateID(run.ID.String())
+	if err == orm.ErrorNotFound || (err == nil && tx.Confirmed) {
+		return
+	} else if err != nil {
+		logger.Errorw("Unable to find transaction of run", run.ForLogger("error", err)...)
+		return
+	}
+
+	for _, attempt := range tx.Attempts {
+		receipt, err := jm.txManager.GetTxReceipt(attempt.Hash)
+		if err != nil {
+			logger.Warnw("Unable to check if transaction of run was mined", run.ForLogger("tx_hash", attempt.Hash.Hex(), "error", err)...)
+			return
+		} else if !receipt.Unconfirmed() {
+			return
+		}
+	}
+
+	if _, err := jm.txManager.CancelTx(tx.Hash); err != nil {
+		logger.Warnw("Unable to abandon transaction of run", run.ForLogger("tx_hash", tx.Hash.Hex(), "error", err)...)
+	}
+}
+
+// CancelRunForCancelledTx cancels the unfinished run that sent the
+// transaction, if any, once the transaction has been replaced by a self-send,
+// so that the run does not complete when the replacement is mined.
+func (jm *runManager) CancelRunForCancelledTx(tx *models.Tx) error {
+	if !tx.SurrogateID.Valid {
+		return nil
+	}
+	runID, err := models.NewIDFromString(tx.SurrogateID.String)
+	if err != nil {
+		return nil
+	}
+
+	run, err := jm.orm.FindJobRun(runID)
+	if err == orm.ErrorNotFound {
+		return nil
+	} else if err != nil {
+		return err
+	} else if run.Status.Finished() {
+		return nil
+	}
+
+	logger.Warnw("Transaction of run was cancelled", run.ForLogger("tx_id", tx.ID)...)
+	run.CancelWithReason(fmt.Sprintf("Transaction %d of run %s was cancelled", tx.ID, run.ID))
+	numberRunsCancelled.Inc()
+	return jm.orm.SaveJobRun(&run)
+}
+
 // Cancel suspends a running task.
 func (jm *runManager) Cancel(runID *models.ID) (*models.JobRun, error) {
 	run, err := jm.orm.FindJobRun(runID)
@@ -251,6 +251,46 @@ func TestRunManager_ResumeAllConnecting(t *testing.T) {
 	})
 }
 
+func TestRunManager_ResumeAllRetrying(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	runQueue := new(mocks.RunQueue)
+	runManager := services.NewRunManager(runQueue, store.Config, store.ORM, store.TxManager, store.Clock)
+
+	job := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.CreateJob(&job))
+
+	newRetryingRun := func(retryAt time.Time) models.JobRun {
+		run := cltest.NewJobRun(job)
+		run.Status = models.RunStatusPendingRetry
+		run.TaskRuns[0].ScheduleRetry(retryAt)
+		require.NoError(t, store.CreateJobRun(&run))
+		return run
+	}
+
+	due := newRetryingRun(time.Now().Add(-time.Second))
+	notDue := newRetryingRun(time.Now().Add(time.Hour))
+
+	runQueue.On("Saturated").Return(false)
+	runQueue.On("Run", mock.MatchedBy(func(run *models.JobRun) bool {
+		return run.ID.String() == due.ID.String()
+	})).Once()
+
+	require.NoError(t, runManager.ResumeAllRetrying())
+
+	due, err := store.FindJobRun(due.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusInProgress, due.Status)
+	assert.Equal(t, models.RunStatusInProgress, due.TaskRuns[0].Status)
+
+	notDue, err = store.FindJobRun(notDue.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusPendingRetry, notDue.Status)
+
+	runQueue.AssertExpectations(t)
+}
+
 func TestRunManager_ResumeAllConnecting_NotEnoughConfirmations(t *testing.T) {
 	t.Parallel()
 	app, cleanup := cltest.NewApplication(t)
@@ -305,6 +345,107 @@ func TestRunManager_Create(t *testing.T) {
 	assert.Equal(t, rr.RequestID, updatedJR.RunRequest.RequestID)
 }
 
+func TestRunManager_Create_RunQueueSaturated(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	runQueue := new(mocks.RunQueue)
+	runQueue.On("Saturated").Return(true)
+	runManager := services.NewRunManager(runQueue, store.Config, store.ORM, store.TxManager, store.Clock)
+
+	job := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.CreateJob(&job))
+
+	initiator := job.Initiators[0]
+	data := cltest.JSONFromString(t, `{"random": "input"}`)
+	jr, err := runManager.Create(job.ID, &initiator, &data, nil, &models.RunRequest{})
+	assert.Equal(t, services.ErrRunQueueSaturated, err)
+	assert.Nil(t, jr)
+
+	runs, err := store.JobRunsFor(job.ID)
+	require.NoError(t, err)
+	assert.Len(t, runs, 0)
+
+	runQueue.AssertExpectations(t)
+}
+
+func TestRunManager_Create_RunQueueSaturated_DefersLogInitiatedRuns(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	runQueue := new(mocks.RunQueue)
+	runQueue.On("Saturated").Return(true).Twice()
+	runManager := services.NewRunManager(runQueue, store.Config, store.ORM, store.TxManager, store.Clock)
+
+	job := cltest.NewJobWithLogInitiator()
+	job.Tasks = []models.TaskSpec{cltest.NewTask(t, "NoOp")}
+	require.NoError(t, store.CreateJob(&job))
+
+	initiator := job.Initiators[0]
+	data := cltest.JSONFromString(t, `{"random": "input"}`)
+	jr, err := runManager.Create(job.ID, &initiator, &data, big.NewInt(1), models.NewRunRequest())
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusPendingRetry, jr.Status)
+
+	// The run stays deferred until the queue has room
+	require.NoError(t, runManager.ResumeAllRetrying())
+	run, err := store.FindJobRun(jr.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusPendingRetry, run.Status)
+
+	runQueue.On("Saturated").Return(false)
+	runQueue.On("Run", mock.MatchedBy(func(run *models.JobRun) bool {
+		return run.ID.String() == jr.ID.String()
+	})).Once()
+	require.NoError(t, runManager.ResumeAllRetrying())
+	run, err = store.FindJobRun(jr.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusInProgress, run.Status)
+
+	runQueue.AssertExpectations(t)
+}
+
+func TestRunManager_Create_DeduplicatesLogs(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	runQueue := new(mocks.RunQueue)
+	runQueue.On("Saturated").Return(false).Once()
+	runQueue.On("Run", mock.Anything).Return().Once()
+	runManager := services.NewRunManager(runQueue, store.Config, store.ORM, store.TxManager, store.Clock)
+
+	job := cltest.NewJobWithLogInitiator()
+	job.Tasks = []models.TaskSpec{cltest.NewTask(t, "NoOp")}
+	require.NoError(t, store.CreateJob(&job))
+
+	initiator := job.Initiators[0]
+	blockHash, txHash := cltest.NewHash(), cltest.NewHash()
+	logIndex := uint(0)
+	data := cltest.JSONFromString(t, `{"random": "input"}`)
+	newRunRequest := func() *models.RunRequest {
+		rr := models.NewRunRequest()
+		rr.BlockHash = &blockHash
+		rr.TxHash = &txHash
+		rr.InitiatorID = &initiator.ID
+		rr.LogIndex = &logIndex
+		return rr
+	}
+
+	jr, err := runManager.Create(job.ID, &initiator, &data, big.NewInt(1), newRunRequest())
+	require.NoError(t, err)
+
+	duplicate, err := runManager.Create(job.ID, &initiator, &data, big.NewInt(1), newRunRequest())
+	require.NoError(t, err)
+	assert.Equal(t, jr.ID, duplicate.ID)
+
+	runs, err := store.JobRunsFor(job.ID)
+	require.NoError(t, err)
+	assert.Len(t, runs, 1)
+
+	runQueue.AssertExpectations(t)
+}
+
 func TestRunManager_Create_DoesNotSaveToTaskSpec(t *testing.T) {
 	t.Parallel()
 	app, cleanup := cltest.NewApplication(t)
@@ -885,3 +1026,173 @@ func TestRunManager_ValidateRun_PaymentBelowThreshold(t *testing.T) {
 	expectedErrorMsg := fmt.Sprintf("Rejecting job %s with payment 1 below minimum threshold (2)", jobSpecID)
 	assert.Equal(t, expectedErrorMsg, run.Result.ErrorMessage.String)
 }
+
+func TestRunManager_InvalidateOrphanedRuns(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	runQueue := new(mocks.RunQueue)
+	runManager := services.NewRunManager(runQueue, store.Config, store.ORM, store.TxManager, store.Clock)
+
+	job := cltest.NewJobWithRunLogInitiator()
+	require.NoError(t, store.CreateJob(&job))
+
+	orphanedHead := cltest.Head(5)
+	canonicalHash := cltest.NewHash()
+
+	newRun := func(blockHash common.Hash, status models.RunStatus) models.JobRun {
+		run := cltest.NewJobRun(job)
+		run.Status = status
+		run.RunRequest.BlockHash = &blockHash
+		require.NoError(t, store.CreateJobRun(&run))
+		return run
+	}
+
+	orphaned := newRun(orphanedHead.Hash, models.RunStatusPendingConfirmations)
+	canonical := newRun(canonicalHash, models.RunStatusPendingConfirmations)
+	completed := newRun(orphanedHead.Hash, models.RunStatusCompleted)
+
+	require.NoError(t, runManager.InvalidateOrphanedRuns([]models.Head{*orphanedHead}))
+
+	orphaned, err := store.FindJobRun(orphaned.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusErrored, orphaned.Status)
+	assert.Contains(t, orphaned.ErrorString(), "orphaned by a chain reorganization")
+
+	canonical, err = store.FindJobRun(canonical.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusPendingConfirmations, canonical.Status)
+
+	completed, err = store.FindJobRun(completed.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusCompleted, completed.Status)
+
+	runQueue.AssertExpectations(t)
+}
+
+func TestRunManager_CancelRunsForRemovedLog(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	runQueue := new(mocks.RunQueue)
+	txManager := new(mocks.TxManager)
+	runManager := services.NewRunManager(runQueue, store.Config, store.ORM, txManager, store.Clock)
+
+	job := cltest.NewJobWithRunLogInitiator()
+	require.NoError(t, store.CreateJob(&job))
+
+	log := eth.Log{
+		TxHash:    cltest.NewHash(),
+		BlockHash: cltest.NewHash(),
+		Index:     3,
+		Removed:   true,
+	}
+
+	newRun := func(txHash common.Hash, status models.RunStatus) models.JobRun {
+		run := cltest.NewJobRun(job)
+		run.Status = status
+		run.RunRequest.TxHash = &txHash
+		run.RunRequest.BlockHash = &log.BlockHash
+		require.NoError(t, store.CreateJobRun(&run))
+		return run
+	}
+
+	removed := newRun(log.TxHash, models.RunStatusPendingConfirmations)
+	other := newRun(cltest.NewHash(), models.RunStatusPendingConfirmations)
+	completed := newRun(log.TxHash, models.RunStatusCompleted)
+
+	tx := cltest.CreateTx(t, store, cltest.NewAddress(), 1)
+	tx.SurrogateID = null.StringFrom(removed.ID.String())
+	require.NoError(t, store.SaveTx(tx))
+
+	txManager.On("GetTxReceipt", tx.Hash).Return(&eth.TxReceipt{}, nil)
+	txManager.On("CancelTx", tx.Hash).Return(tx, nil)
+
+	require.NoError(t, runManager.CancelRunsForRemovedLog(job.Initiators[0], log))
+
+	removed, err := store.FindJobRun(removed.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusCancelled, removed.Status)
+	assert.Contains(t, removed.Result.ErrorMessage.String, "was removed by a chain reorganization")
+
+	other, err = store.FindJobRun(other.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusPendingConfirmations, other.Status)
+
+	completed, err = store.FindJobRun(completed.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusCompleted, completed.Status)
+
+	txManager.AssertExpectations(t)
+}
+
+func TestRunManager_CancelRunsForRemovedLog_MinedTxIsKept(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	runQueue := new(mocks.RunQueue)
+	txManager := new(mocks.TxManager)
+	runManager := services.NewRunManager(runQueue, store.Config, store.ORM, txManager, store.Clock)
+
+	job := cltest.NewJobWithRunLogInitiator()
+	require.NoError(t, store.CreateJob(&job))
+
+	log := eth.Log{TxHash: cltest.NewHash(), BlockHash: cltest.NewHash(), Removed: true}
+	run := cltest.NewJobRun(job)
+	run.Status = models.RunStatusPendingConfirmations
+	run.RunRequest.TxHash = &log.TxHash
+	run.RunRequest.BlockHash = &log.BlockHash
+	require.NoError(t, store.CreateJobRun(&run))
+
+	tx := cltest.CreateTx(t, store, cltest.NewAddress(), 1)
+	tx.SurrogateID = null.StringFrom(run.ID.String())
+	require.NoError(t, store.SaveTx(tx))
+
+	txManager.On("GetTxReceipt", tx.Hash).Return(&eth.TxReceipt{Hash: tx.Hash, BlockNumber: cltest.Int(2)}, nil)
+
+	require.NoError(t, runManager.CancelRunsForRemovedLog(job.Initiators[0], log))
+
+	run, err := store.FindJobRun(run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusCancelled, run.Status)
+
+	txManager.AssertExpectations(t)
+	txManager.AssertNotCalled(t, "CancelTx", mock.Anything)
+}
+
+func TestRunManager_CancelRunForCancelledTx(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	runQueue := new(mocks.RunQueue)
+	txManager := new(mocks.TxManager)
+	runManager := services.NewRunManager(runQueue, store.Config, store.ORM, txManager, store.Clock)
+
+	job := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.CreateJob(&job))
+
+	newTx := func(surrogateID null.String) *models.Tx {
+		tx := cltest.CreateTx(t, store, cltest.NewAddress(), 1)
+		tx.SurrogateID = surrogateID
+		require.NoError(t, store.SaveTx(tx))
+		return tx
+	}
+
+	pending := cltest.CreateJobRunWithStatus(t, store, job, models.RunStatusPendingConfirmations)
+	require.NoError(t, runManager.CancelRunForCancelledTx(newTx(null.StringFrom(pending.ID.String()))))
+
+	pending, err := store.FindJobRun(pending.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusCancelled, pending.Status)
+	assert.Contains(t, pending.Result.ErrorMessage.String, "was cancelled")
+
+	completed := cltest.CreateJobRunWithStatus(t, store, job, models.RunStatusCompleted)
+	require.NoError(t, runManager.CancelRunForCancelledTx(newTx(null.StringFrom(completed.ID.String()))))
+
+	completed, err = store.FindJobRun(completed.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusCompleted, completed.Status)
+
+	require.NoError(t, runManager.CancelRunForCancelledTx(newTx(null.String{})))
+	require.NoError(t, runManager.CancelRunForCancelledTx(newTx(null.StringFrom(models.NewID().String()))))
+}
@@ -1,11 +1,14 @@
 package services
 
 import (
+	"container/heap"
 	"fmt"
 	"sync"
 
+	"chainlink/core/assets"
 	"chainlink/core/logger"
 	"chainlink/core/store/models"
+	"chainlink/core/store/orm"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -20,6 +23,10 @@ var (
 		Name: "run_queue_queue_size",
 		Help: "The size of the run queue",
 	})
+	numberRunsPending = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "run_queue_runs_pending",
+		Help: "The number of runs waiting for a free worker",
+	})
 )
 
 //go:generate mockery -name RunQueue -output ../internal/mocks/ -case=underscore
@@ -31,20 +38,31 @@ type RunQueue interface {
 	Run(*models.JobRun)
 
 	WorkerCount() int
+	QueueDepth() int
+	Saturated() bool
 }
 
 type runQueue struct {
-	workersMutex sync.RWMutex
+	workersMutex sync.Mutex
 	workers      map[string]int
+	jobWorkers   map[string]uint64
 	workersWg    sync.WaitGroup
+	pending      pendingRuns
+	queued       map[string]*pendingRun
+	sequence     uint64
+	stopped      bool
 
+	config      orm.ConfigReader
 	runExecutor RunExecutor
 }
 
 // NewRunQueue initializes a RunQueue.
-func NewRunQueue(runExecutor RunExecutor) RunQueue {
+func NewRunQueue(runExecutor RunExecutor, config orm.ConfigReader) RunQueue {
 	return &runQueue{
 		workers:     make(map[string]int),
+		jobWorkers:  make(map[string]uint64),
+		queued:      make(map[string]*pendingRun),
+		config:      config,
 		runExecutor: runExecutor,
 	}
 }
@@ -54,26 +72,87 @@ func (rq *runQueue) Start() error {
 	return nil
 }
 
-// Stop closes all open worker channels.
+// Stop prevents any pending runs from starting and waits for the runs
+// currently executing to finish. Runs left pending remain in progress in the
+// database and are resumed on the next boot.
 func (rq *runQueue) Stop() {
+	rq.workersMutex.Lock()
+	rq.stopped = true
+	rq.workersMutex.Unlock()
+
 	rq.workersWg.Wait()
 }
 
-// Run tells the job runner to start executing a job
+// Run tells the job runner to start executing a job. If no worker is
+// available the run waits in the queue, with the best paying runs being
+// executed first.
 func (rq *runQueue) Run(run *models.JobRun) {
 	runID := run.ID.String()
 
 	defer numberRunsQueued.Inc()
 
 	rq.workersMutex.Lock()
+	defer rq.workersMutex.Unlock()
+
 	if queueCount, present := rq.workers[runID]; present {
 		rq.workers[runID] = queueCount + 1
-		rq.workersMutex.Unlock()
 		return
 	}
-	rq.workers[runID] = 1
+	if _, present := rq.queued[runID]; present {
+		return
+	}
+
+	pr := &pendingRun{run: run, sequence: rq.sequence}
+	rq.sequence++
+	heap.Push(&rq.pending, pr)
+	rq.queued[runID] = pr
+
+	rq.dispatch()
+}
+
+// dispatch starts workers for the highest priority pending runs until either
+// no runs are pending or the worker limits are reached. Runs whose job is
+// already at its own limit are skipped over. Must be called with
+// workersMutex held.
+func (rq *runQueue) dispatch() {
+	var skipped []*pendingRun
+	for !rq.stopped && rq.pending.Len() > 0 && !rq.atMaxWorkers() {
+		pr := heap.Pop(&rq.pending).(*pendingRun)
+		if rq.atMaxJobWorkers(runJobID(pr.run)) {
+			skipped = append(skipped, pr)
+			continue
+		}
+
+		delete(rq.queued, pr.run.ID.String())
+		rq.startWorker(pr.run)
+	}
+	for _, pr := range skipped {
+		heap.Push(&rq.pending, pr)
+	}
+
 	numberRunQueueWorkers.Set(float64(len(rq.workers)))
-	rq.workersMutex.Unlock()
+	numberRunsPending.Set(float64(rq.pending.Len()))
+}
+
+func (rq *runQueue) atMaxWorkers() bool {
+	max := rq.config.RunQueueMaxWorkers()
+	return max > 0 && uint64(len(rq.workers)) >= max
+}
+
+func (rq *runQueue) atMaxJobWorkers(jobID string) bool {
+	max := rq.config.RunQueueMaxWorkersPerJob()
+	return max > 0 && rq.jobWorkers[jobID] >= max
+}
+
+// startWorker executes the run in its own goroutine, executing it again for
+// every time it was triggered while executing. Must be called with
+// workersMutex held.
+func (rq *runQueue) startWorker(run *models.JobRun) {
+	runID := run.ID.String()
+	jobID := runJobID(run)
+
+	rq.workers[runID] = 1
+	rq.jobWorkers[jobID]++
 
 	rq.workersWg.Add(1)
 	go func() {
@@ -82,7 +161,11 @@ func (rq *runQueue) Run(run *models.JobRun) {
 			queueCount := rq.workers[runID]
 			if queueCount <= 0 {
 				delete(rq.workers, runID)
-				numberRunQueueWorkers.Set(float64(len(rq.workers)))
+				rq.jobWorkers[jobID]--
+				if rq.jobWorkers[jobID] == 0 {
+					delete(rq.jobWorkers, jobID)
+				}
+				rq.dispatch()
 				rq.workersMutex.Unlock()
 				break
 			}
@@ -100,8 +183,71 @@ func (rq *runQueue) Run(run *models.JobRun) {
 
 // WorkerCount returns the number of workers currently processing a job run
 func (rq *runQueue) WorkerCount() int {
-	rq.workersMutex.RLock()
-	defer rq.workersMutex.RUnlock()
+	rq.workersMutex.Lock()
+	defer rq.workersMutex.Unlock()
 
 	return len(rq.workers)
 }
+
+// QueueDepth returns the number of runs waiting for a free worker
+func (rq *runQueue) QueueDepth() int {
+	rq.workersMutex.Lock()
+	defer rq.workersMutex.Unlock()
+
+	return rq.pending.Len()
+}
+
+// Saturated returns true if the number of runs waiting for a free worker has
+// reached the configured maximum, and no more runs should be queued.
+func (rq *runQueue) Saturated() bool {
+	max := rq.config.RunQueueMaxPending()
+	return max > 0 && uint64(rq.QueueDepth()) >= max
+}
+
+type pendingRun struct {
+	run      *models.JobRun
+	sequence uint64
+}
+
+// pendingRuns implements heap.Interface, ordering runs by their payment,
+// highest first, and then by the order in which they were queued.
+type pendingRuns []*pendingRun
+
+func (pr pendingRuns) Len() int { return len(pr) }
+
+func (pr pendingRuns) Less(i, j int) bool {
+	cmp := runPayment(pr[i].run).Cmp(runPayment(pr[j].run))
+	if cmp != 0 {
+		return cmp > 0
+	}
+	return pr[i].sequence < pr[j].sequence
+}
+
+func (pr pendingRuns) Swap(i, j int) { pr[i], pr[j] = pr[j], pr[i] }
+
+func (pr *pendingRuns) Push(x interface{}) {
+	*pr = append(*pr, x.(*pendingRun))
+}
+
+func (pr *pendingRuns) Pop() interface{} {
+	old := *pr
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	*pr = old[:n-1]
+	return item
+}
+
+func runJobID(run *models.JobRun) string {
+	if run.JobSpecID == nil {
+		return ""
+	}
+	return run.JobSpecID.String()
+}
+
+func runPayment(run *models.JobRun) *assets.Link {
+	if run.RunRequest.Payment == nil {
+		return assets.NewLink(0)
+	}
+	return run.RunRequest.Payment
+}
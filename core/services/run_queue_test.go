@@ -3,12 +3,14 @@ package services_test
 import (
 	"testing"
 
+	"chainlink/core/assets"
 	"chainlink/core/internal/cltest"
 	"chainlink/core/internal/mocks"
 	"chainlink/core/services"
 	"chainlink/core/store/models"
 
 	"github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -17,7 +19,7 @@ func TestRunQueue(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 
 	runExecutor := new(mocks.RunExecutor)
-	runQueue := services.NewRunQueue(runExecutor)
+	runQueue := services.NewRunQueue(runExecutor, cltest.NewTestConfig(t))
 
 	executeJobChannel := make(chan struct{})
 
@@ -52,7 +54,7 @@ func TestRunQueue_OneWorkerPerRun(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 
 	runExecutor := new(mocks.RunExecutor)
-	runQueue := services.NewRunQueue(runExecutor)
+	runQueue := services.NewRunQueue(runExecutor, cltest.NewTestConfig(t))
 
 	executeJobChannel := make(chan struct{})
 
@@ -89,7 +91,7 @@ func TestRunQueue_OneWorkerForSameRunTriggeredMultipleTimes(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 
 	runExecutor := new(mocks.RunExecutor)
-	runQueue := services.NewRunQueue(runExecutor)
+	runQueue := services.NewRunQueue(runExecutor, cltest.NewTestConfig(t))
 
 	executeJobChannel := make(chan struct{})
 
@@ -125,3 +127,172 @@ func TestRunQueue_OneWorkerForSameRunTriggeredMultipleTimes(t *testing.T) {
 		return runQueue.WorkerCount()
 	}).Should(gomega.Equal(0))
 }
+
+func TestRunQueue_MaxWorkers(t *testing.T) {
+	t.Parallel()
+	g := gomega.NewGomegaWithT(t)
+
+	config := cltest.NewTestConfig(t)
+	config.Set("RUN_QUEUE_MAX_WORKERS", 1)
+	runExecutor := new(mocks.RunExecutor)
+	runQueue := services.NewRunQueue(runExecutor, config)
+
+	executeJobChannel := make(chan struct{})
+
+	runQueue.Start()
+	defer runQueue.Stop()
+
+	runExecutor.On("Execute", mock.Anything).
+		Return(nil, nil).
+		Run(func(mock.Arguments) {
+			executeJobChannel <- struct{}{}
+		})
+
+	runQueue.Run(&models.JobRun{ID: models.NewID()})
+	runQueue.Run(&models.JobRun{ID: models.NewID()})
+
+	g.Eventually(func() int {
+		return runQueue.WorkerCount()
+	}).Should(gomega.Equal(1))
+	assert.Equal(t, 1, runQueue.QueueDepth())
+
+	cltest.CallbackOrTimeout(t, "Execute", func() {
+		<-executeJobChannel
+	})
+
+	g.Eventually(func() int {
+		return runQueue.QueueDepth()
+	}).Should(gomega.Equal(0))
+	assert.Equal(t, 1, runQueue.WorkerCount())
+
+	cltest.CallbackOrTimeout(t, "Execute", func() {
+		<-executeJobChannel
+	})
+
+	runExecutor.AssertExpectations(t)
+
+	g.Eventually(func() int {
+		return runQueue.WorkerCount()
+	}).Should(gomega.Equal(0))
+}
+
+func TestRunQueue_MaxWorkersPerJob(t *testing.T) {
+	t.Parallel()
+	g := gomega.NewGomegaWithT(t)
+
+	config := cltest.NewTestConfig(t)
+	config.Set("RUN_QUEUE_MAX_WORKERS_PER_JOB", 1)
+	runExecutor := new(mocks.RunExecutor)
+	runQueue := services.NewRunQueue(runExecutor, config)
+
+	executeJobChannel := make(chan struct{})
+
+	runQueue.Start()
+	defer runQueue.Stop()
+
+	runExecutor.On("Execute", mock.Anything).
+		Return(nil, nil).
+		Run(func(mock.Arguments) {
+			executeJobChannel <- struct{}{}
+		})
+
+	jobID := models.NewID()
+	runQueue.Run(&models.JobRun{ID: models.NewID(), JobSpecID: jobID})
+	runQueue.Run(&models.JobRun{ID: models.NewID(), JobSpecID: jobID})
+	runQueue.Run(&models.JobRun{ID: models.NewID(), JobSpecID: models.NewID()})
+
+	g.Eventually(func() int {
+		return runQueue.WorkerCount()
+	}).Should(gomega.Equal(2))
+	assert.Equal(t, 1, runQueue.QueueDepth())
+
+	cltest.CallbackOrTimeout(t, "Execute", func() {
+		<-executeJobChannel
+		<-executeJobChannel
+		<-executeJobChannel
+	})
+
+	runExecutor.AssertExpectations(t)
+
+	g.Eventually(func() int {
+		return runQueue.WorkerCount()
+	}).Should(gomega.Equal(0))
+	assert.Equal(t, 0, runQueue.QueueDepth())
+}
+
+func TestRunQueue_PrioritizesHigherPayments(t *testing.T) {
+	t.Parallel()
+
+	config := cltest.NewTestConfig(t)
+	config.Set("RUN_QUEUE_MAX_WORKERS", 1)
+	runExecutor := new(mocks.RunExecutor)
+	runQueue := services.NewRunQueue(runExecutor, config)
+
+	executed := make(chan *models.ID)
+
+	runQueue.Start()
+	defer runQueue.Stop()
+
+	runExecutor.On("Execute", mock.Anything).
+		Return(nil, nil).
+		Run(func(args mock.Arguments) {
+			executed <- args.Get(0).(*models.ID)
+		})
+
+	first := &models.JobRun{ID: models.NewID()}
+	lowPayment := &models.JobRun{ID: models.NewID(), RunRequest: models.RunRequest{Payment: assets.NewLink(1)}}
+	highPayment := &models.JobRun{ID: models.NewID(), RunRequest: models.RunRequest{Payment: assets.NewLink(10)}}
+
+	runQueue.Run(first)
+	runQueue.Run(lowPayment)
+	runQueue.Run(highPayment)
+
+	var order []*models.ID
+	cltest.CallbackOrTimeout(t, "Execute", func() {
+		for i := 0; i < 3; i++ {
+			order = append(order, <-executed)
+		}
+	})
+
+	assert.Equal(t, []*models.ID{first.ID, highPayment.ID, lowPayment.ID}, order)
+	runExecutor.AssertExpectations(t)
+}
+
+func TestRunQueue_Saturated(t *testing.T) {
+	t.Parallel()
+	g := gomega.NewGomegaWithT(t)
+
+	config := cltest.NewTestConfig(t)
+	config.Set("RUN_QUEUE_MAX_WORKERS", 1)
+	config.Set("RUN_QUEUE_MAX_PENDING", 1)
+	runExecutor := new(mocks.RunExecutor)
+	runQueue := services.NewRunQueue(runExecutor, config)
+
+	executeJobChannel := make(chan struct{})
+
+	runQueue.Start()
+	defer runQueue.Stop()
+
+	runExecutor.On("Execute", mock.Anything).
+		Return(nil, nil).
+		Run(func(mock.Arguments) {
+			executeJobChannel <- struct{}{}
+		})
+
+	runQueue.Run(&models.JobRun{ID: models.NewID()})
+	g.Eventually(func() int {
+		return runQueue.WorkerCount()
+	}).Should(gomega.Equal(1))
+	assert.False(t, runQueue.Saturated())
+
+	runQueue.Run(&models.JobRun{ID: models.NewID()})
+	assert.True(t, runQueue.Saturated())
+
+	cltest.CallbackOrTimeout(t, "Execute", func() {
+		<-executeJobChannel
+		<-executeJobChannel
+	})
+
+	runExecutor.AssertExpectations(t)
+	assert.False(t, runQueue.Saturated())
+}
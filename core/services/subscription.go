@@ -3,6 +3,8 @@ package services
 import (
 	"fmt"
 	"math/big"
+	"sort"
+	"sync"
 	"time"
 
 	"chainlink/core/eth"
@@ -29,9 +31,16 @@ type JobSubscription struct {
 }
 
 // StartJobSubscription constructs a JobSubscription which listens for and
-// tracks event logs corresponding to the specified job. Ignores any errors if
-// there is at least one successful subscription to an initiator log.
-func StartJobSubscription(job models.JobSpec, head *models.Head, store *strpkg.Store, runManager RunManager) (JobSubscription, error) {
+// tracks event logs corresponding to the specified job, subscribing through
+// the log broadcaster. Ignores any errors if there is at least one successful
+// subscription to an initiator log.
+func StartJobSubscription(
+	job models.JobSpec,
+	head *models.Head,
+	store *strpkg.Store,
+	runManager RunManager,
+	logBroadcaster *LogBroadcaster,
+) (JobSubscription, error) {
 	var merr error
 	var unsubscribers []Unsubscriber
 
@@ -48,7 +57,29 @@ func StartJobSubscription(job models.JobSpec, head *models.Head, store *strpkg.S
 	}
 
 	for _, initr := range initrs {
-		unsubscriber, err := NewInitiatorSubscription(initr, store.TxManager, runManager, nextHead, ReceiveLogRequest)
+		fromBlock := nextHead
+		if store.Config.ReplayFromBlock() < 0 && initr.LastProcessedBlock != nil {
+			// Resume from the last processed block rather than after it, since
+			// not all of its logs may have been processed. Runs are only
+			// created once per log, so the logs already processed are skipped.
+			fromBlock = initr.LastProcessedBlock.ToInt()
+		}
+
+		recorder := newLastProcessedBlockRecorder(store, initr)
+		backfill := LogBackfill{
+			Head:        head.ToInt(),
+			BlockWindow: store.Config.LogBackfillBlockWindow(),
+			MaxRetries:  store.Config.LogBackfillMaxRetries(),
+			LatestBlock: func() (*big.Int, error) {
+				block, err := store.TxManager.GetBlockByNumber("latest")
+				return block.Number.ToInt(), err
+			},
+			OnProgress: recorder.recordBackfilled,
+			OnFailure:  recorder.holdAfterFailedBackfill,
+			OnComplete: recorder.resumeAfterBackfill,
+		}
+		callback := recorder.wrap(ReceiveLogRequest)
+		unsubscriber, err := NewInitiatorSubscription(initr, logBroadcaster, runManager, fromBlock, backfill, callback)
 		if err == nil {
 			unsubscribers = append(unsubscribers, unsubscriber)
 		} else {
@@ -64,6 +95,92 @@ func StartJobSubscription(job models.JobSpec, head *models.Head, store *strpkg.S
 	return JobSubscription{Job: job, unsubscribers: unsubscribers}, merr
 }
 
+// lastProcessedBlockRecorder records the last block an initiator processed,
+// from which its subscription resumes on restart. Once a run could not be
+// created for a log, it stops recording, so that the log is received again on
+// restart. Once the logs of a backfill window could not be retrieved, it stops
+// recording until a later backfill retrieves them.
+type lastProcessedBlockRecorder struct {
+	store              *strpkg.Store
+	initr              models.Initiator
+	lastProcessedBlock *big.Int
+	missedLog          bool
+	backfillFailed     bool
+}
+
+func newLastProcessedBlockRecorder(store *strpkg.Store, initr models.Initiator) *lastProcessedBlockRecorder {
+	return &lastProcessedBlockRecorder{
+		store:              store,
+		initr:              initr,
+		lastProcessedBlock: initr.LastProcessedBlock.ToInt(),
+	}
+}
+
+// record saves the block number, unless an equal or later block has already
+// been recorded, or logs were missed.
+func (r *lastProcessedBlockRecorder) record(blockNumber *big.Int) error {
+	if r.missedLog || r.backfillFailed {
+		return nil
+	}
+	if r.lastProcessedBlock != nil && blockNumber.Cmp(r.lastProcessedBlock) <= 0 {
+		return nil
+	}
+	if err := r.store.SetLastProcessedBlock(&r.initr, blockNumber); err != nil {
+		return err
+	}
+	r.lastProcessedBlock = blockNumber
+	return nil
+}
+
+// recordBackfilled records the last block of a completed backfill window, so
+// that an interrupted backfill resumes after it.
+func (r *lastProcessedBlockRecorder) recordBackfilled(blockNumber *big.Int) {
+	if err := r.record(blockNumber); err != nil {
+		logger.Errorw("Unable to record last backfilled block", "error", err, "blockNumber", blockNumber.String(), "job", r.initr.JobSpecID.String())
+	}
+}
+
+// holdAfterFailedBackfill stops recording blocks after a backfill window
+// could not be retrieved, so that the window is backfilled again, by the next
+// backfill or on restart, rather than skipped by the blocks of later logs.
+func (r *lastProcessedBlockRecorder) holdAfterFailedBackfill(fromBlock *big.Int) {
+	logger.Errorw("Backfill failed, not recording later blocks until it is retried", "fromBlock", fromBlock.String(), "job", r.initr.JobSpecID.String())
+	r.backfillFailed = true
+}
+
+// resumeAfterBackfill records blocks again once a backfill has completed,
+// which retrieves the windows of any earlier failed backfill.
+func (r *lastProcessedBlockRecorder) resumeAfterBackfill() {
+	if r.backfillFailed {
+		logger.Infow("Backfill retried, recording blocks again", "job", r.initr.JobSpecID.String())
+		r.backfillFailed = false
+	}
+}
+
+// wrap wraps callback to record the block of each log the initiator has
+// processed. Logs arrive in block order, so every log in an earlier block has
+// already been processed by the time a block is recorded. The rest of the
+// logs in the block are received again on restart, as the subscription
+// resumes from the recorded block rather than after it. A log rejected as
+// its job is not started, ended or archived is not missed, as it would be
+// rejected again on restart.
+func (r *lastProcessedBlockRecorder) wrap(callback func(RunManager, models.LogRequest) error) func(RunManager, models.LogRequest) error {
+	return func(runManager RunManager, le models.LogRequest) error {
+		if err := callback(runManager, le); err != nil {
+			if !ExpectedRecurringScheduleJobError(err) {
+				r.missedLog = true
+			}
+			return err
+		}
+		if !le.GetLog().Removed {
+			if err := r.record(le.BlockNumber()); err != nil {
+				logger.Errorw("Unable to record last processed block", le.ForLogger("error", err)...)
+			}
+		}
+		return nil
+	}
+}
+
 // Unsubscribe stops the subscription and cleans up associated resources.
 func (js JobSubscription) Unsubscribe() {
 	for _, sub := range js.unsubscribers {
@@ -78,17 +195,18 @@ type InitiatorSubscription struct {
 	*ManagedSubscription
 	runManager RunManager
 	Initiator  models.Initiator
-	callback   func(RunManager, models.LogRequest)
+	callback   func(RunManager, models.LogRequest) error
 }
 
 // NewInitiatorSubscription creates a new InitiatorSubscription that feeds received
 // logs to the callback func parameter.
 func NewInitiatorSubscription(
 	initr models.Initiator,
-	client eth.Client,
+	logSubscriber eth.LogSubscriber,
 	runManager RunManager,
 	nextHead *big.Int,
-	callback func(RunManager, models.LogRequest),
+	backfill LogBackfill,
+	callback func(RunManager, models.LogRequest) error,
 ) (InitiatorSubscription, error) {
 
 	filter, err := models.FilterQueryFactory(initr, nextHead)
@@ -102,7 +220,7 @@ func NewInitiatorSubscription(
 		callback:   callback,
 	}
 
-	managedSub, err := NewManagedSubscription(client, filter, sub.dispatchLog)
+	managedSub, err := NewManagedSubscription(logSubscriber, filter, backfill, sub.dispatchLog)
 	if err != nil {
 		return sub, errors.Wrap(err, "NewInitiatorSubscription#NewManagedSubscription")
 	}
@@ -116,11 +234,22 @@ func (sub InitiatorSubscription) dispatchLog(log eth.Log) {
 	logger.Debugw(fmt.Sprintf("Log for %v initiator for job %s", sub.Initiator.Type, sub.Initiator.JobSpecID.String()),
 		"txHash", log.TxHash.Hex(), "logIndex", log.Index, "blockNumber", log.BlockNumber, "job", sub.Initiator.JobSpecID.String())
 
+	// A removed log was dropped from the chain by a reorganization, so any
+	// run it already triggered must not fulfil the request.
+	if log.Removed {
+		if err := sub.runManager.CancelRunsForRemovedLog(sub.Initiator, log); err != nil {
+			logger.Errorw("Unable to cancel runs for removed log", "error", err, "txHash", log.TxHash.Hex(), "job", sub.Initiator.JobSpecID.String())
+		}
+		return
+	}
+
 	base := models.InitiatorLogEvent{
 		Initiator: sub.Initiator,
 		Log:       log,
 	}
-	sub.callback(sub.runManager, base.LogRequest())
+	if err := sub.callback(sub.runManager, base.LogRequest()); err != nil {
+		logger.Errorw("Unable to process log", "error", err, "txHash", log.TxHash.Hex(), "logIndex", log.Index, "job", sub.Initiator.JobSpecID.String())
+	}
 }
 
 func loggerLogListening(initr models.Initiator, blockNumber *big.Int) {
@@ -129,68 +258,107 @@ func loggerLogListening(initr models.Initiator, blockNumber *big.Int) {
 }
 
 // ReceiveLogRequest parses the log and runs the job indicated by a RunLog or
-// ServiceAgreementExecutionLog. (Both log events have the same format.)
-func ReceiveLogRequest(runManager RunManager, le models.LogRequest) {
+// ServiceAgreementExecutionLog. (Both log events have the same format.) It
+// returns an error if the run could not be created, in which case the log
+// should be received again. Logs that cannot be parsed are only logged, as
+// they would never create a run.
+func ReceiveLogRequest(runManager RunManager, le models.LogRequest) error {
 	if !le.Validate() {
-		return
+		return nil
 	}
 
 	if le.GetLog().Removed {
 		logger.Debugw("Skipping run for removed log", "log", le.GetLog(), "jobId", le.GetJobSpecID().String())
-		return
+		return nil
 	}
 
 	le.ToDebug()
 	data, err := le.JSON()
 	if err != nil {
 		logger.Errorw(err.Error(), le.ForLogger()...)
-		return
+		return nil
 	}
 
-	runJob(runManager, le, data)
+	return runJob(runManager, le, data)
 }
 
-func runJob(runManager RunManager, le models.LogRequest, data models.JSON) {
+func runJob(runManager RunManager, le models.LogRequest, data models.JSON) error {
 	jobSpecID := le.GetJobSpecID()
 	initiator := le.GetInitiator()
 
 	if err := le.ValidateRequester(); err != nil {
-		if _, err := runManager.CreateErrored(jobSpecID, initiator, err); err != nil {
-			logger.Errorw(err.Error())
-		}
 		logger.Errorw(err.Error(), le.ForLogger()...)
-		return
+		_, err := runManager.CreateErrored(jobSpecID, initiator, err)
+		return err
 	}
 
 	rr, err := le.RunRequest()
 	if err != nil {
-		if _, err := runManager.CreateErrored(jobSpecID, initiator, err); err != nil {
-			logger.Errorw(err.Error())
-		}
 		logger.Errorw(err.Error(), le.ForLogger()...)
-		return
+		_, err := runManager.CreateErrored(jobSpecID, initiator, err)
+		return err
 	}
 
 	_, err = runManager.Create(jobSpecID, &initiator, &data, le.BlockNumber(), &rr)
-	if err != nil {
-		logger.Errorw(err.Error(), le.ForLogger()...)
-	}
+	return err
+}
+
+// logBackfillBatchSize is the number of backfill windows whose logs are
+// retrieved in a single batch request.
+const logBackfillBatchSize = 10
+
+// LogBackfill configures how a ManagedSubscription backfills the logs since
+// the FromBlock of its filter. The zero value backfills them in a single
+// request, without retrying.
+type LogBackfill struct {
+	// Head is the block number of the current head. The logs up to it are
+	// retrieved in windows of at most BlockWindow blocks, and the logs after
+	// it in a final request.
+	Head        *big.Int
+	BlockWindow uint64
+	// MaxRetries is how many times the retrieval of a batch of windows is
+	// retried, backing off in between, before the backfill is given up.
+	MaxRetries uint64
+	// LatestBlock returns the number of the latest block, up to which the
+	// logs missed while resubscribing are retrieved in windows. They are
+	// retrieved in a single request if it is nil or fails.
+	LatestBlock func() (*big.Int, error)
+	// OnProgress is called with the last block of each completed window, once
+	// all of its logs have been handled.
+	OnProgress func(blockNumber *big.Int)
+	// OnFailure is called with the first block of the windows whose logs
+	// could not be retrieved when the backfill is given up. The next backfill,
+	// after resubscribing, starts from that block.
+	OnFailure func(fromBlock *big.Int)
+	// OnComplete is called once all the logs of a backfill have been handled.
+	OnComplete func()
 }
 
 // ManagedSubscription encapsulates the connecting, backfilling, and clean up of an
-// ethereum node subscription.
+// ethereum node subscription. If the subscription errors, for instance when
+// failing over to another ethereum node, it resubscribes and backfills the
+// logs missed in the meantime.
 type ManagedSubscription struct {
 	logSubscriber   eth.LogSubscriber
 	logs            chan eth.Log
 	ethSubscription eth.Subscription
+	backfill        LogBackfill
 	callback        func(eth.Log)
+	lastBlock       *big.Int
+	missedFrom      *big.Int
+	sleeper         utils.Sleeper
+	mutex           sync.Mutex
+	done            chan struct{}
+	unsubscribeOnce sync.Once
 }
 
 // NewManagedSubscription subscribes to the ethereum node with the passed filter
-// and delegates incoming logs to callback.
+// and delegates incoming logs to callback, after backfilling the logs since
+// the filter's FromBlock.
 func NewManagedSubscription(
 	logSubscriber eth.LogSubscriber,
 	filter ethereum.FilterQuery,
+	backfill LogBackfill,
 	callback func(eth.Log),
 ) (*ManagedSubscription, error) {
 	logs := make(chan eth.Log)
@@ -201,20 +369,29 @@ func NewManagedSubscription(
 
 	sub := &ManagedSubscription{
 		logSubscriber:   logSubscriber,
+		backfill:        backfill,
 		callback:        callback,
 		logs:            logs,
 		ethSubscription: es,
+		sleeper:         utils.NewBackoffSleeper(),
+		done:            make(chan struct{}),
 	}
 	go sub.listenToLogs(filter)
 	return sub, nil
 }
 
 // Unsubscribe closes channels and cleans up resources.
-func (sub ManagedSubscription) Unsubscribe() {
-	if sub.ethSubscription != nil {
-		timedUnsubscribe(sub.ethSubscription)
-	}
-	close(sub.logs)
+func (sub *ManagedSubscription) Unsubscribe() {
+	sub.unsubscribeOnce.Do(func() {
+		sub.mutex.Lock()
+		close(sub.done)
+		es := sub.ethSubscription
+		sub.mutex.Unlock()
+
+		if es != nil {
+			timedUnsubscribe(es)
+		}
+	})
 }
 
 // timedUnsubscribe attempts to unsubscribe but aborts abruptly after a time delay
@@ -234,43 +411,205 @@ func timedUnsubscribe(unsubscriber Unsubscriber) {
 	}
 }
 
-func (sub ManagedSubscription) listenToLogs(q ethereum.FilterQuery) {
-	backfilledSet := sub.backfillLogs(q)
+func (sub *ManagedSubscription) listenToLogs(q ethereum.FilterQuery) {
+	backfilledSet := sub.backfillLogs(q, sub.backfill.Head)
 	for {
 		select {
-		case log, open := <-sub.logs:
-			if !open {
-				return
-			}
+		case <-sub.done:
+			return
+		case log := <-sub.logs:
 			if _, present := backfilledSet[log.BlockHash.String()]; !present {
 				sub.callback(log)
 			}
-		case err, ok := <-sub.ethSubscription.Err():
-			if ok {
+			sub.lastBlock = new(big.Int).SetUint64(log.BlockNumber)
+		case err := <-sub.subscriptionErr():
+			if err != nil {
 				logger.Errorw(fmt.Sprintf("Error in log subscription: %s", err.Error()), "err", err)
 			}
+			if !sub.resubscribe(q) {
+				return
+			}
+
+			// Backfill the logs missed while resubscribing, starting after the
+			// last log received, or from the windows of a failed backfill.
+			if sub.lastBlock != nil {
+				q.FromBlock = new(big.Int).Add(sub.lastBlock, big.NewInt(1))
+			}
+			if sub.missedFrom != nil && (q.FromBlock == nil || sub.missedFrom.Cmp(q.FromBlock) < 0) {
+				q.FromBlock = sub.missedFrom
+			}
+			sub.missedFrom = nil
+			for blockHash := range sub.backfillLogs(q, sub.latestBlock()) {
+				backfilledSet[blockHash] = true
+			}
+		}
+	}
+}
+
+// latestBlock returns the number of the latest block, or nil if it is not
+// known.
+func (sub *ManagedSubscription) latestBlock() *big.Int {
+	if sub.backfill.LatestBlock == nil {
+		return nil
+	}
+	latest, err := sub.backfill.LatestBlock()
+	if err != nil {
+		logger.Warnw("Unable to get latest block, backfilling logs in a single request", "err", err)
+		return nil
+	}
+	return latest
+}
+
+func (sub *ManagedSubscription) subscriptionErr() <-chan error {
+	sub.mutex.Lock()
+	defer sub.mutex.Unlock()
+	return sub.ethSubscription.Err()
+}
+
+// resubscribe replaces the subscription, backing off between attempts. It
+// returns false if unsubscribed before it could resubscribe.
+func (sub *ManagedSubscription) resubscribe(q ethereum.FilterQuery) bool {
+	sub.mutex.Lock()
+	timedUnsubscribe(sub.ethSubscription)
+	sub.mutex.Unlock()
+
+	sub.sleeper.Reset()
+	for {
+		select {
+		case <-sub.done:
+			return false
+		case <-time.After(sub.sleeper.After()):
+		}
+
+		es, err := sub.logSubscriber.SubscribeToLogs(sub.logs, q)
+		if err != nil {
+			logger.Warnw("Unable to resubscribe to logs", "err", err, "retryIn", sub.sleeper.Duration())
+			continue
+		}
+
+		sub.mutex.Lock()
+		defer sub.mutex.Unlock()
+		select {
+		case <-sub.done:
+			timedUnsubscribe(es)
+			return false
+		default:
+			sub.ethSubscription = es
+			logger.Info("Resubscribed to logs")
+			return true
 		}
 	}
 }
 
 // Manually retrieve old logs since SubscribeToLogs(logs, filter) only returns newly
 // imported blocks: https://github.com/ethereum/go-ethereum/wiki/RPC-PUB-SUB#logs
-// Therefore TxManager.GetLogs does a one time retrieval of old logs.
-func (sub ManagedSubscription) backfillLogs(q ethereum.FilterQuery) map[string]bool {
+// Therefore TxManager.GetLogsBatch does a one time retrieval of old logs, split
+// into windows up to head which are handled in block order. Up to
+// logBackfillBatchSize windows are retrieved in a single batch request,
+// throttled by MAX_RPC_CALLS_PER_SECOND like any other call.
+func (sub *ManagedSubscription) backfillLogs(q ethereum.FilterQuery, head *big.Int) map[string]bool {
 	backfilledSet := map[string]bool{}
 	if q.FromBlock == nil {
 		return backfilledSet
 	}
 
-	logs, err := sub.logSubscriber.GetLogs(q)
-	if err != nil {
-		logger.Errorw("Unable to backfill logs", "err", err, "fromBlock", q.FromBlock.String(), "toBlock", q.ToBlock.String())
-		return backfilledSet
+	last := head
+	if q.ToBlock != nil && (last == nil || q.ToBlock.Cmp(last) < 0) {
+		last = q.ToBlock
 	}
 
-	for _, log := range logs {
-		backfilledSet[log.BlockHash.String()] = true
-		sub.callback(log)
+	for from := q.FromBlock; from != nil; {
+		select {
+		case <-sub.done:
+			return backfilledSet
+		default:
+		}
+
+		var windows []ethereum.FilterQuery
+		for from != nil && len(windows) < logBackfillBatchSize {
+			var window ethereum.FilterQuery
+			window, from = backfillWindow(q, from, last, sub.backfill.BlockWindow)
+			windows = append(windows, window)
+		}
+		batch, ok := sub.getLogs(windows)
+		if !ok {
+			return backfilledSet
+		}
+
+		for n, logs := range batch {
+			sort.SliceStable(logs, func(i, j int) bool {
+				if logs[i].BlockNumber != logs[j].BlockNumber {
+					return logs[i].BlockNumber < logs[j].BlockNumber
+				}
+				return logs[i].Index < logs[j].Index
+			})
+			for _, log := range logs {
+				backfilledSet[log.BlockHash.String()] = true
+				sub.callback(log)
+				sub.lastBlock = new(big.Int).SetUint64(log.BlockNumber)
+			}
+
+			if final := from == nil && n == len(windows)-1; !final {
+				window := windows[n]
+				logger.Infow("Backfilled logs", "fromBlock", window.FromBlock.String(), "toBlock", window.ToBlock.String(), "backfillTo", last.String(), "logs", len(logs))
+				if sub.backfill.OnProgress != nil {
+					sub.backfill.OnProgress(window.ToBlock)
+				}
+			}
+		}
+	}
+
+	if sub.backfill.OnComplete != nil {
+		sub.backfill.OnComplete()
 	}
 	return backfilledSet
 }
+
+// backfillWindow returns the filter for the backfill window starting at from,
+// which spans blockWindow blocks, and the first block of the next window. The
+// window reaching last is the final one, for which the next block is nil. It
+// extends to the filter's ToBlock, or the latest block if it has none, to
+// also cover the blocks mined since head.
+func backfillWindow(q ethereum.FilterQuery, from, last *big.Int, blockWindow uint64) (ethereum.FilterQuery, *big.Int) {
+	window := q
+	window.FromBlock = from
+	if last == nil || blockWindow == 0 {
+		return window, nil
+	}
+
+	to := new(big.Int).Add(from, new(big.Int).SetUint64(blockWindow-1))
+	if to.Cmp(last) >= 0 {
+		return window, nil
+	}
+	window.ToBlock = to
+	return window, new(big.Int).Add(to, big.NewInt(1))
+}
+
+// getLogs retrieves the logs of a batch of backfill windows, retrying with a
+// backoff. It returns false if the logs could not be retrieved, or the
+// subscription was unsubscribed while retrying.
+func (sub *ManagedSubscription) getLogs(qs []ethereum.FilterQuery) ([][]eth.Log, bool) {
+	fromBlock, toBlock := qs[0].FromBlock, qs[len(qs)-1].ToBlock
+	sleeper := utils.NewBackoffSleeper()
+	for retries := uint64(0); ; retries++ {
+		batch, err := sub.logSubscriber.GetLogsBatch(qs)
+		if err == nil {
+			return batch, true
+		}
+		if retries >= sub.backfill.MaxRetries {
+			logger.Errorw("Unable to backfill logs", "err", err, "fromBlock", fromBlock.String(), "toBlock", toBlock.String())
+			sub.missedFrom = fromBlock
+			if sub.backfill.OnFailure != nil {
+				sub.backfill.OnFailure(fromBlock)
+			}
+			return nil, false
+		}
+
+		logger.Warnw("Unable to backfill logs, retrying", "err", err, "fromBlock", fromBlock.String(), "toBlock", toBlock.String(), "retryIn", sleeper.Duration())
+		select {
+		case <-sub.done:
+			return nil, false
+		case <-time.After(sleeper.After()):
+		}
+	}
+}
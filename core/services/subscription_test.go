@@ -1,9 +1,12 @@
 package services_test
 
 import (
+	"errors"
 	"math/big"
+	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
@@ -22,6 +25,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v3"
 )
 
 func TestServices_NewInitiatorSubscription_BackfillLogs(t *testing.T) {
@@ -38,10 +42,13 @@ func TestServices_NewInitiatorSubscription_BackfillLogs(t *testing.T) {
 	eth.RegisterSubscription("logs")
 
 	var count int32
-	callback := func(services.RunManager, models.LogRequest) { atomic.AddInt32(&count, 1) }
+	callback := func(services.RunManager, models.LogRequest) error {
+		atomic.AddInt32(&count, 1)
+		return nil
+	}
 	fromBlock := cltest.Head(0)
 	jm := new(mocks.RunManager)
-	sub, err := services.NewInitiatorSubscription(initr, store.TxManager, jm, fromBlock.NextInt(), callback)
+	sub, err := services.NewInitiatorSubscription(initr, store.TxManager, jm, fromBlock.NextInt(), services.LogBackfill{}, callback)
 	assert.NoError(t, err)
 	defer sub.Unsubscribe()
 
@@ -64,9 +71,12 @@ func TestServices_NewInitiatorSubscription_BackfillLogs_WithNoHead(t *testing.T)
 	eth.RegisterSubscription("logs")
 
 	var count int32
-	callback := func(services.RunManager, models.LogRequest) { atomic.AddInt32(&count, 1) }
+	callback := func(services.RunManager, models.LogRequest) error {
+		atomic.AddInt32(&count, 1)
+		return nil
+	}
 	jm := new(mocks.RunManager)
-	sub, err := services.NewInitiatorSubscription(initr, store.TxManager, jm, nil, callback)
+	sub, err := services.NewInitiatorSubscription(initr, store.TxManager, jm, nil, services.LogBackfill{}, callback)
 	assert.NoError(t, err)
 	defer sub.Unsubscribe()
 
@@ -74,6 +84,145 @@ func TestServices_NewInitiatorSubscription_BackfillLogs_WithNoHead(t *testing.T)
 	assert.Equal(t, int32(0), atomic.LoadInt32(&count))
 }
 
+func TestServices_NewInitiatorSubscription_Resubscribes(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	eth := cltest.MockEthOnStore(t, store)
+
+	job := cltest.NewJobWithLogInitiator()
+	initr := job.Initiators[0]
+
+	firstLogs := make(chan ethpkg.Log)
+	firstSub := eth.RegisterSubscription("logs", firstLogs)
+
+	var count int32
+	callback := func(services.RunManager, models.LogRequest) error {
+		atomic.AddInt32(&count, 1)
+		return nil
+	}
+	jm := new(mocks.RunManager)
+	sub, err := services.NewInitiatorSubscription(initr, store.TxManager, jm, nil, services.LogBackfill{}, callback)
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	firstLogs <- cltest.LogFromFixture(t, "testdata/subscription_logs.json")
+
+	// The log sent while resubscribing is backfilled
+	missedLog := cltest.LogFromFixture(t, "testdata/requestLog0original.json")
+	eth.Register("eth_getLogs", []ethpkg.Log{missedLog})
+	eth.RegisterSubscription("logs")
+	firstSub.Errors <- errors.New("connection lost")
+
+	g := gomega.NewGomegaWithT(t)
+	g.Eventually(eth.Remaining, 5*time.Second).Should(gomega.HaveLen(0))
+	g.Eventually(func() int32 { return atomic.LoadInt32(&count) }).Should(gomega.Equal(int32(2)))
+}
+
+func TestServices_NewInitiatorSubscription_ResubscribeBackfillsInWindows(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	eth := cltest.MockEthOnStore(t, store)
+
+	job := cltest.NewJobWithLogInitiator()
+	initr := job.Initiators[0]
+
+	firstLogs := make(chan ethpkg.Log)
+	firstSub := eth.RegisterSubscription("logs", firstLogs)
+
+	callback := func(services.RunManager, models.LogRequest) error { return nil }
+	backfill := services.LogBackfill{
+		BlockWindow: 5,
+		LatestBlock: func() (*big.Int, error) { return big.NewInt(91), nil },
+	}
+	sub, err := services.NewInitiatorSubscription(initr, store.TxManager, new(mocks.RunManager), nil, backfill, callback)
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	log := cltest.LogFromFixture(t, "testdata/subscription_logs.json")
+	log.BlockNumber = 80
+	firstLogs <- log
+
+	// The logs missed while resubscribing are backfilled in windows up to
+	// the latest block
+	var mutex sync.Mutex
+	var windows [][2]interface{}
+	recordWindow := func(_ interface{}, args ...interface{}) error {
+		arg := args[0].([]interface{})[0].(map[string]interface{})
+		mutex.Lock()
+		defer mutex.Unlock()
+		windows = append(windows, [2]interface{}{arg["fromBlock"], arg["toBlock"]})
+		return nil
+	}
+	eth.Register("eth_getLogs", []ethpkg.Log{}, recordWindow)
+	eth.Register("eth_getLogs", []ethpkg.Log{}, recordWindow)
+	eth.Register("eth_getLogs", []ethpkg.Log{}, recordWindow)
+	eth.RegisterSubscription("logs")
+	firstSub.Errors <- errors.New("connection lost")
+
+	eth.EventuallyAllCalled(t)
+	mutex.Lock()
+	defer mutex.Unlock()
+	assert.Equal(t, [][2]interface{}{
+		{"0x51", "0x55"},
+		{"0x56", "0x5a"},
+		{"0x5b", "latest"},
+	}, windows)
+}
+
+func TestServices_NewInitiatorSubscription_ResubscribeRetriesFailedBackfill(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	eth := cltest.MockEthOnStore(t, store)
+
+	job := cltest.NewJobWithLogInitiator()
+	initr := job.Initiators[0]
+
+	eth.RegisterError("eth_getLogs", "query timeout exceeded")
+	firstLogs := make(chan ethpkg.Log)
+	firstSub := eth.RegisterSubscription("logs", firstLogs)
+
+	failedFrom := make(chan *big.Int, 1)
+	completed := make(chan struct{}, 1)
+	backfill := services.LogBackfill{
+		OnFailure:  func(fromBlock *big.Int) { failedFrom <- fromBlock },
+		OnComplete: func() { completed <- struct{}{} },
+	}
+	callback := func(services.RunManager, models.LogRequest) error { return nil }
+	sub, err := services.NewInitiatorSubscription(initr, store.TxManager, new(mocks.RunManager), big.NewInt(80), backfill, callback)
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	cltest.CallbackOrTimeout(t, "backfill failed", func() {
+		assert.Equal(t, big.NewInt(80), <-failedFrom)
+	})
+
+	log := cltest.LogFromFixture(t, "testdata/subscription_logs.json")
+	log.BlockNumber = 95
+	firstLogs <- log
+
+	// The failed backfill is retried after resubscribing, rather than only
+	// the logs after the last one received
+	var fromBlock interface{}
+	eth.Register("eth_getLogs", []ethpkg.Log{}, func(_ interface{}, args ...interface{}) error {
+		fromBlock = args[0].([]interface{})[0].(map[string]interface{})["fromBlock"]
+		return nil
+	})
+	eth.RegisterSubscription("logs")
+	firstSub.Errors <- errors.New("connection lost")
+
+	cltest.CallbackOrTimeout(t, "backfill completed", func() {
+		<-completed
+	})
+	eth.EventuallyAllCalled(t)
+	assert.Equal(t, "0x50", fromBlock)
+}
+
 func TestServices_NewInitiatorSubscription_PreventsDoubleDispatch(t *testing.T) {
 	t.Parallel()
 
@@ -90,10 +239,13 @@ func TestServices_NewInitiatorSubscription_PreventsDoubleDispatch(t *testing.T)
 	eth.RegisterSubscription("logs", logsChan)
 
 	var count int32
-	callback := func(services.RunManager, models.LogRequest) { atomic.AddInt32(&count, 1) }
+	callback := func(services.RunManager, models.LogRequest) error {
+		atomic.AddInt32(&count, 1)
+		return nil
+	}
 	head := cltest.Head(0)
 	jm := new(mocks.RunManager)
-	sub, err := services.NewInitiatorSubscription(initr, store.TxManager, jm, head.NextInt(), callback)
+	sub, err := services.NewInitiatorSubscription(initr, store.TxManager, jm, head.NextInt(), services.LogBackfill{}, callback)
 	assert.NoError(t, err)
 	defer sub.Unsubscribe()
 
@@ -108,6 +260,45 @@ func TestServices_NewInitiatorSubscription_PreventsDoubleDispatch(t *testing.T)
 	g.Eventually(func() int32 { return atomic.LoadInt32(&count) }).Should(gomega.Equal(int32(2)))
 }
 
+func TestServices_NewInitiatorSubscription_CancelsRunsForRemovedLogs(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	eth := cltest.MockEthOnStore(t, store)
+
+	job := cltest.NewJobWithLogInitiator()
+	initr := job.Initiators[0]
+	logsChan := make(chan ethpkg.Log)
+	eth.RegisterSubscription("logs", logsChan)
+
+	log := cltest.LogFromFixture(t, "testdata/subscription_logs.json")
+	log.Removed = true
+
+	cancelled := make(chan struct{})
+	jm := new(mocks.RunManager)
+	jm.On("CancelRunsForRemovedLog", initr, log).Return(nil).Run(func(mock.Arguments) {
+		close(cancelled)
+	})
+
+	var count int32
+	callback := func(services.RunManager, models.LogRequest) error {
+		atomic.AddInt32(&count, 1)
+		return nil
+	}
+	sub, err := services.NewInitiatorSubscription(initr, store.TxManager, jm, nil, services.LogBackfill{}, callback)
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	logsChan <- log
+
+	cltest.CallbackOrTimeout(t, "CancelRunsForRemovedLog", func() {
+		<-cancelled
+	})
+	assert.Equal(t, int32(0), atomic.LoadInt32(&count))
+	jm.AssertExpectations(t)
+}
+
 func TestServices_ReceiveLogRequest_IgnoredLogWithRemovedFlag(t *testing.T) {
 	t.Parallel()
 
@@ -128,7 +319,7 @@ func TestServices_ReceiveLogRequest_IgnoredLogWithRemovedFlag(t *testing.T) {
 	require.NoError(t, err)
 
 	jm := new(mocks.RunManager)
-	services.ReceiveLogRequest(jm, log)
+	require.NoError(t, services.ReceiveLogRequest(jm, log))
 	jm.AssertExpectations(t)
 }
 
@@ -232,7 +423,7 @@ func TestServices_StartJobSubscription(t *testing.T) {
 					executeJobChannel <- struct{}{}
 				})
 
-			subscription, err := services.StartJobSubscription(job, cltest.Head(91), store, runManager)
+			subscription, err := services.StartJobSubscription(job, cltest.Head(91), store, runManager, services.NewLogBroadcaster(store.TxManager))
 			require.NoError(t, err)
 			assert.NotNil(t, subscription)
 
@@ -257,6 +448,264 @@ func TestServices_StartJobSubscription(t *testing.T) {
 	}
 }
 
+func TestServices_StartJobSubscription_ResumesFromLastProcessedBlock(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithLogInitiator()
+	require.NoError(t, store.CreateJob(&job))
+	require.NoError(t, store.SetLastProcessedBlock(&job.Initiators[0], big.NewInt(80)))
+	job, err := store.FindJob(job.ID)
+	require.NoError(t, err)
+
+	log := cltest.LogFromFixture(t, "testdata/subscription_logs.json")
+	log.BlockNumber = 85
+	var fromBlock interface{}
+	eth := cltest.MockEthOnStore(t, store)
+	eth.Register("eth_getLogs", []ethpkg.Log{log}, func(_ interface{}, args ...interface{}) error {
+		fromBlock = args[0].([]interface{})[0].(map[string]interface{})["fromBlock"]
+		return nil
+	})
+	eth.RegisterSubscription("logs")
+
+	runManager := new(mocks.RunManager)
+	runManager.On("Create", job.ID, mock.Anything, mock.Anything, big.NewInt(85), mock.Anything).
+		Return(nil, nil)
+
+	subscription, err := services.StartJobSubscription(job, cltest.Head(91), store, runManager, services.NewLogBroadcaster(store.TxManager))
+	require.NoError(t, err)
+	defer subscription.Unsubscribe()
+
+	eth.EventuallyAllCalled(t)
+	assert.Equal(t, "0x50", fromBlock)
+
+	gomega.NewGomegaWithT(t).Eventually(func() *big.Int {
+		initr, err := store.FindInitiator(job.Initiators[0].ID)
+		require.NoError(t, err)
+		return initr.LastProcessedBlock.ToInt()
+	}).Should(gomega.Equal(big.NewInt(85)))
+}
+
+func TestServices_StartJobSubscription_DoesNotRecordBlockOfFailedRun(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithLogInitiator()
+	require.NoError(t, store.CreateJob(&job))
+	require.NoError(t, store.SetLastProcessedBlock(&job.Initiators[0], big.NewInt(80)))
+	job, err := store.FindJob(job.ID)
+	require.NoError(t, err)
+
+	failed := cltest.LogFromFixture(t, "testdata/subscription_logs.json")
+	failed.BlockNumber = 85
+	later := failed
+	later.BlockNumber = 86
+	later.BlockHash = cltest.NewHash()
+	eth := cltest.MockEthOnStore(t, store)
+	eth.Register("eth_getLogs", []ethpkg.Log{failed, later})
+	eth.RegisterSubscription("logs")
+
+	created := make(chan struct{}, 2)
+	runManager := new(mocks.RunManager)
+	runManager.On("Create", job.ID, mock.Anything, mock.Anything, big.NewInt(85), mock.Anything).
+		Return(nil, errors.New("database is locked")).
+		Run(func(mock.Arguments) { created <- struct{}{} })
+	runManager.On("Create", job.ID, mock.Anything, mock.Anything, big.NewInt(86), mock.Anything).
+		Return(nil, nil).
+		Run(func(mock.Arguments) { created <- struct{}{} })
+
+	subscription, err := services.StartJobSubscription(job, cltest.Head(91), store, runManager, services.NewLogBroadcaster(store.TxManager))
+	require.NoError(t, err)
+	defer subscription.Unsubscribe()
+
+	for i := 0; i < 2; i++ {
+		cltest.CallbackOrTimeout(t, "run created", func() {
+			<-created
+		})
+	}
+	runManager.AssertExpectations(t)
+
+	// The log that failed to create a run is received again on restart
+	gomega.NewGomegaWithT(t).Consistently(func() *big.Int {
+		initr, err := store.FindInitiator(job.Initiators[0].ID)
+		require.NoError(t, err)
+		return initr.LastProcessedBlock.ToInt()
+	}).Should(gomega.Equal(big.NewInt(80)))
+}
+
+func TestServices_StartJobSubscription_RecordsBlockOfRejectedRun(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	store.Config.Set(orm.EnvVarName("LogBackfillBlockWindow"), 5)
+
+	job := cltest.NewJobWithLogInitiator()
+	job.EndAt = null.TimeFrom(time.Now().Add(-time.Hour))
+	require.NoError(t, store.CreateJob(&job))
+	require.NoError(t, store.SetLastProcessedBlock(&job.Initiators[0], big.NewInt(80)))
+	job, err := store.FindJob(job.ID)
+	require.NoError(t, err)
+
+	log := cltest.LogFromFixture(t, "testdata/subscription_logs.json")
+	log.BlockNumber = 85
+	eth := cltest.MockEthOnStore(t, store)
+	eth.Register("eth_getLogs", []ethpkg.Log{})
+	eth.Register("eth_getLogs", []ethpkg.Log{log})
+	eth.Register("eth_getLogs", []ethpkg.Log{})
+	eth.RegisterSubscription("logs")
+
+	runQueue := new(mocks.RunQueue)
+	runManager := services.NewRunManager(runQueue, store.Config, store.ORM, store.TxManager, store.Clock)
+
+	subscription, err := services.StartJobSubscription(job, cltest.Head(91), store, runManager, services.NewLogBroadcaster(store.TxManager))
+	require.NoError(t, err)
+	defer subscription.Unsubscribe()
+
+	// The log is rejected as the job has ended, which it would be again on
+	// restart, so the blocks after it are still recorded
+	eth.EventuallyAllCalled(t)
+	gomega.NewGomegaWithT(t).Eventually(func() *big.Int {
+		initr, err := store.FindInitiator(job.Initiators[0].ID)
+		require.NoError(t, err)
+		return initr.LastProcessedBlock.ToInt()
+	}).Should(gomega.Equal(big.NewInt(89)))
+	runQueue.AssertNotCalled(t, "Run", mock.Anything)
+}
+
+func TestServices_StartJobSubscription_BackfillsInWindows(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	store.Config.Set(orm.EnvVarName("LogBackfillBlockWindow"), 5)
+
+	job := cltest.NewJobWithLogInitiator()
+	require.NoError(t, store.CreateJob(&job))
+	require.NoError(t, store.SetLastProcessedBlock(&job.Initiators[0], big.NewInt(80)))
+	job, err := store.FindJob(job.ID)
+	require.NoError(t, err)
+
+	log := cltest.LogFromFixture(t, "testdata/subscription_logs.json")
+	log.BlockNumber = 85
+	var windows [][2]interface{}
+	recordWindow := func(_ interface{}, args ...interface{}) error {
+		arg := args[0].([]interface{})[0].(map[string]interface{})
+		windows = append(windows, [2]interface{}{arg["fromBlock"], arg["toBlock"]})
+		return nil
+	}
+	eth := cltest.MockEthOnStore(t, store)
+	eth.Register("eth_getLogs", []ethpkg.Log{}, recordWindow)
+	eth.Register("eth_getLogs", []ethpkg.Log{log}, recordWindow)
+	eth.Register("eth_getLogs", []ethpkg.Log{}, recordWindow)
+	eth.RegisterSubscription("logs")
+
+	runManager := new(mocks.RunManager)
+	runManager.On("Create", job.ID, mock.Anything, mock.Anything, big.NewInt(85), mock.Anything).
+		Return(nil, nil)
+
+	subscription, err := services.StartJobSubscription(job, cltest.Head(91), store, runManager, services.NewLogBroadcaster(store.TxManager))
+	require.NoError(t, err)
+	defer subscription.Unsubscribe()
+
+	eth.EventuallyAllCalled(t)
+	assert.Equal(t, [][2]interface{}{
+		{"0x50", "0x54"},
+		{"0x55", "0x59"},
+		{"0x5a", "latest"},
+	}, windows)
+	runManager.AssertExpectations(t)
+
+	// The end of each completed window is recorded, to resume from
+	gomega.NewGomegaWithT(t).Eventually(func() *big.Int {
+		initr, err := store.FindInitiator(job.Initiators[0].ID)
+		require.NoError(t, err)
+		return initr.LastProcessedBlock.ToInt()
+	}).Should(gomega.Equal(big.NewInt(89)))
+}
+
+func TestServices_StartJobSubscription_RetriesBackfill(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	store.Config.Set(orm.EnvVarName("LogBackfillMaxRetries"), 1)
+	store.Config.Set(orm.EnvVarName("ReplayFromBlock"), 80)
+
+	job := cltest.NewJobWithLogInitiator()
+	require.NoError(t, store.CreateJob(&job))
+	log := cltest.LogFromFixture(t, "testdata/subscription_logs.json")
+	log.BlockNumber = 85
+	eth := cltest.MockEthOnStore(t, store)
+	eth.RegisterError("eth_getLogs", "query timeout exceeded")
+	eth.Register("eth_getLogs", []ethpkg.Log{log})
+	eth.RegisterSubscription("logs")
+
+	created := make(chan struct{}, 1)
+	runManager := new(mocks.RunManager)
+	runManager.On("Create", job.ID, mock.Anything, mock.Anything, big.NewInt(85), mock.Anything).
+		Return(nil, nil).
+		Run(func(mock.Arguments) { created <- struct{}{} })
+
+	subscription, err := services.StartJobSubscription(job, cltest.Head(91), store, runManager, services.NewLogBroadcaster(store.TxManager))
+	require.NoError(t, err)
+	defer subscription.Unsubscribe()
+
+	eth.EventuallyAllCalled(t)
+	cltest.CallbackOrTimeout(t, "run created for backfilled log", func() {
+		<-created
+	})
+}
+
+func TestServices_StartJobSubscription_FailedBackfillIsNotSkipped(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	log := cltest.LogFromFixture(t, "testdata/subscription_logs.json")
+	log.BlockNumber = 95
+	job := cltest.NewJobWithLogInitiator()
+	job.Initiators[0].Address = log.Address
+	require.NoError(t, store.CreateJob(&job))
+	require.NoError(t, store.SetLastProcessedBlock(&job.Initiators[0], big.NewInt(80)))
+	job, err := store.FindJob(job.ID)
+	require.NoError(t, err)
+
+	eth := cltest.MockEthOnStore(t, store)
+	eth.RegisterError("eth_getLogs", "query timeout exceeded")
+	logs := make(chan ethpkg.Log, 1)
+	eth.RegisterSubscription("logs", logs)
+
+	created := make(chan struct{}, 1)
+	runManager := new(mocks.RunManager)
+	runManager.On("Create", job.ID, mock.Anything, mock.Anything, big.NewInt(95), mock.Anything).
+		Return(nil, nil).
+		Run(func(mock.Arguments) { created <- struct{}{} })
+
+	subscription, err := services.StartJobSubscription(job, cltest.Head(91), store, runManager, services.NewLogBroadcaster(store.TxManager))
+	require.NoError(t, err)
+	defer subscription.Unsubscribe()
+
+	eth.EventuallyAllCalled(t)
+	logs <- log
+	cltest.CallbackOrTimeout(t, "run created for live log", func() {
+		<-created
+	})
+
+	// The blocks of live logs are not recorded, so that the failed backfill
+	// resumes on restart
+	gomega.NewGomegaWithT(t).Consistently(func() *big.Int {
+		initr, err := store.FindInitiator(job.Initiators[0].ID)
+		require.NoError(t, err)
+		return initr.LastProcessedBlock.ToInt()
+	}).Should(gomega.Equal(big.NewInt(80)))
+}
+
 func TestServices_StartJobSubscription_RunlogNoTopicMatch(t *testing.T) {
 	t.Parallel()
 
@@ -293,7 +742,7 @@ func TestServices_StartJobSubscription_RunlogNoTopicMatch(t *testing.T) {
 
 			runManager := new(mocks.RunManager)
 
-			subscription, err := services.StartJobSubscription(job, cltest.Head(91), store, runManager)
+			subscription, err := services.StartJobSubscription(job, cltest.Head(91), store, runManager, services.NewLogBroadcaster(store.TxManager))
 			require.NoError(t, err)
 			assert.NotNil(t, subscription)
 
@@ -356,7 +805,7 @@ func TestServices_NewInitiatorSubscription_EthLog_ReplayFromBlock(t *testing.T)
 			log := cltest.LogFromFixture(t, "testdata/subscription_logs.json")
 
 			txManager.On("SubscribeToLogs", mock.Anything, expectedQuery).Return(cltest.EmptyMockSubscription(), nil)
-			txManager.On("GetLogs", expectedQuery).Return([]ethpkg.Log{log}, nil)
+			txManager.On("GetLogsBatch", []ethereum.FilterQuery{expectedQuery}).Return([][]ethpkg.Log{{log}}, nil)
 
 			executeJobChannel := make(chan struct{})
 
@@ -367,7 +816,7 @@ func TestServices_NewInitiatorSubscription_EthLog_ReplayFromBlock(t *testing.T)
 					executeJobChannel <- struct{}{}
 				})
 
-			_, err := services.StartJobSubscription(job, currentHead, store, runManager)
+			_, err := services.StartJobSubscription(job, currentHead, store, runManager, services.NewLogBroadcaster(store.TxManager))
 			require.NoError(t, err)
 
 			<-executeJobChannel
@@ -419,7 +868,7 @@ func TestServices_NewInitiatorSubscription_RunLog_ReplayFromBlock(t *testing.T)
 			log.Topics[1] = models.IDToTopic(job.ID)
 
 			txmMock.On("SubscribeToLogs", mock.Anything, expectedQuery).Return(cltest.EmptyMockSubscription(), nil)
-			txmMock.On("GetLogs", expectedQuery).Return([]ethpkg.Log{log}, nil)
+			txmMock.On("GetLogsBatch", []ethereum.FilterQuery{expectedQuery}).Return([][]ethpkg.Log{{log}}, nil)
 
 			executeJobChannel := make(chan struct{})
 
@@ -430,7 +879,7 @@ func TestServices_NewInitiatorSubscription_RunLog_ReplayFromBlock(t *testing.T)
 					executeJobChannel <- struct{}{}
 				})
 
-			_, err := services.StartJobSubscription(job, currentHead, store, runManager)
+			_, err := services.StartJobSubscription(job, currentHead, store, runManager, services.NewLogBroadcaster(store.TxManager))
 			require.NoError(t, err)
 
 			<-executeJobChannel
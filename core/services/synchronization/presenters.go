@@ -124,10 +124,14 @@ const (
 	// StatusNoFulfilledRunLog indicates that no ChainlinkFulfilled events were
 	// detected in the transaction receipt.
 	StatusNoFulfilledRunLog = "noFulfilledRunLog"
+	// StatusReverted indicates that the transaction was mined but reverted.
+	StatusReverted = "reverted"
 )
 
 func runLogStatusPresenter(receipt eth.TxReceipt) TxStatus {
-	if receipt.FulfilledRunLog() {
+	if receipt.Reverted() {
+		return StatusReverted
+	} else if receipt.FulfilledRunLog() {
 		return StatusFulfilledRunLog
 	}
 	return StatusNoFulfilledRunLog
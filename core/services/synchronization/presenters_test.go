@@ -165,6 +165,7 @@ func TestSyncJobRunPresenter_EthTxTask(t *testing.T) {
 		{"confirmed", "testdata/confirmedEthTxData.json", ""},
 		{"safe fulfilled", "testdata/fulfilledReceiptResponse.json", "fulfilledRunLog"},
 		{"safe not fulfilled", "testdata/notFulfilledReceiptResponse.json", "noFulfilledRunLog"},
+		{"safe reverted", "testdata/revertedReceiptResponse.json", "reverted"},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
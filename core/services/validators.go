@@ -13,6 +13,7 @@ import (
 	"chainlink/core/utils"
 
 	"github.com/asaskevich/govalidator"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/tidwall/gjson"
 )
@@ -31,12 +32,78 @@ func ValidateJob(j models.JobSpec, store *store.Store) error {
 		if err := ValidateInitiator(i, j); err != nil {
 			fe.Merge(err)
 		}
+		if err := validateFromAddresses(i.FromAddresses, store); err != nil {
+			fe.Merge(err)
+		}
 	}
 	for _, task := range j.Tasks {
 		if err := validateTask(task, store); err != nil {
 			fe.Merge(err)
 		}
 	}
+	if err := validateTaskGraph(j.Tasks); err != nil {
+		fe.Merge(err)
+	}
+	return fe.CoerceEmptyToNil()
+}
+
+// validateTaskGraph checks that the inputs of a job's tasks reference other
+// tasks of the job by their unique ID, and that no task depends on itself.
+func validateTaskGraph(tasks []models.TaskSpec) error {
+	fe := models.NewJSONAPIErrors()
+	byID := make(map[string]models.TaskSpec)
+	for _, task := range tasks {
+		if task.TaskID == "" {
+			continue
+		}
+		if _, exists := byID[task.TaskID]; exists {
+			fe.Add(fmt.Sprintf("Task ID %v is not unique", task.TaskID))
+		}
+		byID[task.TaskID] = task
+	}
+
+	for _, task := range tasks {
+		for _, input := range task.Inputs {
+			if input == task.TaskID {
+				fe.Add(fmt.Sprintf("Task %v cannot be its own input", input))
+			} else if _, exists := byID[input]; !exists {
+				fe.Add(fmt.Sprintf("Task input %v does not match the ID of any task", input))
+			}
+		}
+	}
+	if len(fe.Errors) > 0 {
+		return fe
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int)
+	var visit func(id string) bool
+	visit = func(id string) bool {
+		switch state[id] {
+		case visiting:
+			return false
+		case visited:
+			return true
+		}
+		state[id] = visiting
+		for _, input := range byID[id].Inputs {
+			if !visit(input) {
+				return false
+			}
+		}
+		state[id] = visited
+		return true
+	}
+	for _, task := range tasks {
+		if task.TaskID != "" && !visit(task.TaskID) {
+			fe.Add(fmt.Sprintf("Task %v depends on itself through its inputs", task.TaskID))
+			break
+		}
+	}
 	return fe.CoerceEmptyToNil()
 }
 
@@ -128,12 +195,39 @@ func validateFluxMonitor(i models.Initiator, j models.JobSpec) error {
 	if len(i.Feeds) == 0 {
 		fe.Add("unable to create job config, no feeds")
 	}
-	if i.Threshold <= 0 {
+	if i.Threshold < 0 || (i.Threshold == 0 && i.AbsoluteThreshold == nil) {
 		fe.Add("unable to create job config, bad threshold")
 	}
+	if i.AbsoluteThreshold != nil && !i.AbsoluteThreshold.IsPositive() {
+		fe.Add("unable to create job config, bad absoluteThreshold")
+	}
+	switch i.ThresholdMode {
+	case "", models.ThresholdModeOr, models.ThresholdModeAnd:
+	default:
+		fe.Add(fmt.Sprintf("unable to create job config, thresholdMode must be %s or %s", models.ThresholdModeOr, models.ThresholdModeAnd))
+	}
+	if i.MinAnswer != nil && i.MaxAnswer != nil && i.MinAnswer.GreaterThan(*i.MaxAnswer) {
+		fe.Add("unable to create job config, minAnswer exceeds maxAnswer")
+	}
 	if i.RequestData.String() == "" {
 		fe.Add("unable to create job config, no requestdata")
 	}
+	if int(i.MinimumResponses) > len(i.Feeds) {
+		fe.Add("unable to create job config, minimumResponses exceeds the number of feeds")
+	}
+	pollInterval := defaultPollInterval
+	if i.PollInterval != 0 {
+		pollInterval = i.PollInterval.Duration()
+	}
+	if pollInterval < time.Second {
+		fe.Add("unable to create job config, pollInterval must be at least 1s")
+	}
+	if i.HTTPTimeout < 0 || i.HTTPTimeout.Duration() > pollInterval {
+		fe.Add("unable to create job config, httpTimeout must be positive and at most the pollInterval")
+	}
+	if i.IdleThreshold != 0 && i.IdleThreshold.Duration() < pollInterval {
+		fe.Add("unable to create job config, idleThreshold must be at least the pollInterval")
+	}
 
 	return fe.CoerceEmptyToNil()
 }
@@ -197,6 +291,12 @@ func validateServiceAgreementInitiator(i models.Initiator, j models.JobSpec) err
 }
 
 func validateTask(task models.TaskSpec, store *store.Store) error {
+	if task.Retry != nil {
+		if err := task.Retry.Validate(); err != nil {
+			return err
+		}
+	}
+
 	adapter, err := adapters.For(task, store.Config, store.ORM)
 	if !store.Config.Dev() {
 		if _, ok := adapter.BaseAdapter.(*adapters.Sleep); ok {
@@ -206,7 +306,33 @@ func validateTask(task models.TaskSpec, store *store.Store) error {
 			return errors.New("EthTxABIEncode Adapter is not implemented yet")
 		}
 	}
-	return err
+	if err != nil {
+		return err
+	}
+
+	switch ba := adapter.BaseAdapter.(type) {
+	case *adapters.EthTx:
+		return validateFromAddresses(ba.FromAddresses, store)
+	case *adapters.EthTxABIEncode:
+		return validateFromAddresses(ba.FromAddresses, store)
+	}
+	return nil
+}
+
+// validateFromAddresses checks that the node holds a key for each of the
+// addresses a job asks to send transactions from.
+func validateFromAddresses(fromAddresses []common.Address, store *store.Store) error {
+	fe := models.NewJSONAPIErrors()
+	held := make(map[common.Address]bool)
+	for _, account := range store.KeyStore.GetAccounts() {
+		held[account.Address] = true
+	}
+	for _, address := range fromAddresses {
+		if !held[address] {
+			fe.Add(fmt.Sprintf("From address %v is not one of this node's accounts", address.Hex()))
+		}
+	}
+	return fe.CoerceEmptyToNil()
 }
 
 // ValidateServiceAgreement checks the ServiceAgreement for any application logic errors.
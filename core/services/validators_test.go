@@ -70,6 +70,16 @@ func TestValidateJob(t *testing.T) {
 			cltest.MustReadFile(t, "testdata/runlog_2_ethlogs_job.json"),
 			models.NewJSONAPIErrorsWith("Cannot RunLog initiated jobs cannot have more than one EthTx Task"),
 		},
+		{
+			"task graph with a cycle",
+			cltest.MustReadFile(t, "testdata/task_graph_cycle_job.json"),
+			models.NewJSONAPIErrorsWith("Task first depends on itself through its inputs"),
+		},
+		{
+			"task graph with an unknown input",
+			cltest.MustReadFile(t, "testdata/task_graph_unknown_input_job.json"),
+			models.NewJSONAPIErrorsWith("Task input third does not match the ID of any task"),
+		},
 	}
 
 	store, cleanup := cltest.NewStore(t)
@@ -99,6 +109,45 @@ func TestValidateJob_DevRejectsSleepAdapter(t *testing.T) {
 	assert.Error(t, services.ValidateJob(sleepingJob, store))
 }
 
+func TestValidateJob_FromAddresses(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	require.NoError(t, utils.JustError(store.KeyStore.NewAccount(cltest.Password)))
+	held := cltest.GetAccountAddress(t, store)
+	unknown := cltest.NewAddress()
+
+	tests := []struct {
+		name          string
+		taskFrom      string
+		initiatorFrom models.AddressCollection
+		wantErr       bool
+	}{
+		{"none", ``, nil, false},
+		{"held task address", fmt.Sprintf(`{"fromAddresses": ["%s"]}`, held.Hex()), nil, false},
+		{"unknown task address", fmt.Sprintf(`{"fromAddresses": ["%s", "%s"]}`, held.Hex(), unknown.Hex()), nil, true},
+		{"held initiator address", ``, models.AddressCollection{held}, false},
+		{"unknown initiator address", ``, models.AddressCollection{unknown}, true},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			job := cltest.NewJobWithFluxMonitorInitiator()
+			job.Initiators[0].FromAddresses = test.initiatorFrom
+			job.Tasks = []models.TaskSpec{cltest.NewTask(t, "ethtx", test.taskFrom)}
+
+			err := services.ValidateJob(job, store)
+			if test.wantErr {
+				assert.Contains(t, fmt.Sprint(err), unknown.Hex())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestValidateBridgeType(t *testing.T) {
 	t.Parallel()
 
@@ -388,6 +437,30 @@ func TestValidateInitiator_FluxMonitorHappy(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestValidateInitiator_FluxMonitorTimingHappy(t *testing.T) {
+	job := cltest.NewJob()
+	initrJSON := cltest.MustJSONSet(t, validInitiator, "params.pollInterval", "30s")
+	initrJSON = cltest.MustJSONSet(t, initrJSON, "params.httpTimeout", "10s")
+	initrJSON = cltest.MustJSONSet(t, initrJSON, "params.idleThreshold", "5m")
+
+	var initr models.Initiator
+	require.NoError(t, json.Unmarshal([]byte(initrJSON), &initr))
+	assert.Equal(t, 30*time.Second, initr.PollInterval.Duration())
+	assert.NoError(t, services.ValidateInitiator(initr, job))
+}
+
+func TestValidateInitiator_FluxMonitorAbsoluteThresholdHappy(t *testing.T) {
+	job := cltest.NewJob()
+	initrJSON := cltest.MustJSONDel(t, validInitiator, "params.threshold")
+	initrJSON = cltest.MustJSONSet(t, initrJSON, "params.absoluteThreshold", "0.005")
+	initrJSON = cltest.MustJSONSet(t, initrJSON, "params.minAnswer", "0.9")
+	initrJSON = cltest.MustJSONSet(t, initrJSON, "params.maxAnswer", "1.1")
+
+	var initr models.Initiator
+	require.NoError(t, json.Unmarshal([]byte(initrJSON), &initr))
+	assert.NoError(t, services.ValidateInitiator(initr, job))
+}
+
 func TestValidateInitiator_FluxMonitorErrors(t *testing.T) {
 	job := cltest.NewJob()
 	tests := []struct {
@@ -399,6 +472,13 @@ func TestValidateInitiator_FluxMonitorErrors(t *testing.T) {
 		{"threshold", cltest.MustJSONDel(t, validInitiator, "params.threshold")},
 		{"threshold", cltest.MustJSONSet(t, validInitiator, "params.threshold", -5)},
 		{"requestdata", cltest.MustJSONDel(t, validInitiator, "params.requestdata")},
+		{"minimumResponses", cltest.MustJSONSet(t, validInitiator, "params.minimumResponses", 4)},
+		{"pollInterval", cltest.MustJSONSet(t, validInitiator, "params.pollInterval", "500ms")},
+		{"httpTimeout", cltest.MustJSONSet(t, validInitiator, "params.httpTimeout", "2m")},
+		{"idleThreshold", cltest.MustJSONSet(t, validInitiator, "params.idleThreshold", "30s")},
+		{"absoluteThreshold", cltest.MustJSONSet(t, validInitiator, "params.absoluteThreshold", "-0.01")},
+		{"thresholdMode", cltest.MustJSONSet(t, validInitiator, "params.thresholdMode", "xor")},
+		{"minAnswer", cltest.MustJSONSet(t, cltest.MustJSONSet(t, validInitiator, "params.minAnswer", 10), "params.maxAnswer", 5)},
 	}
 	for _, test := range tests {
 		t.Run("bad "+test.Field, func(t *testing.T) {
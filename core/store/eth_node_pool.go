@@ -0,0 +1,471 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"chainlink/core/eth"
+	"chainlink/core/logger"
+	"chainlink/core/store/orm"
+	"chainlink/core/utils"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/tevino/abool"
+	"golang.org/x/time/rate"
+)
+
+var (
+	numberEthNodeCalls = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "eth_node_calls",
+		Help: "The total number of RPC calls made to each ethereum node",
+	},
+		[]string{"node"},
+	)
+	numberEthNodeCallErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "eth_node_call_errors",
+		Help: "The total number of RPC calls that could not reach each ethereum node",
+	},
+		[]string{"node"},
+	)
+	numberEthNodeHealthy = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "eth_node_healthy",
+		Help: "Whether each ethereum node passed its last health check",
+	},
+		[]string{"node"},
+	)
+	numberEthNodeHead = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "eth_node_head",
+		Help: "The latest block number reported by each ethereum node",
+	},
+		[]string{"node"},
+	)
+	numberEthNodeFailovers = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "eth_node_failovers",
+		Help: "The total number of times the active ethereum node was switched",
+	})
+)
+
+// minCallsForErrorRate is the fewest calls made to a node between health
+// checks for its error rate to be taken into account.
+const minCallsForErrorRate = 10
+
+// errFailover is the error sent on the subscriptions made through a node when
+// failing over from it, so that subscribers resubscribe through the new
+// active node.
+var errFailover = errors.New("failed over to another ethereum node")
+
+// ethNodePool spreads calls and subscriptions over the ethereum nodes given in
+// ETH_URL. Everything is sent to the active node, which is the first healthy
+// node in the order they were given, failing over to the next healthy node if
+// a call cannot reach it. The health of each node is checked every
+// ETH_HEALTH_CHECK_INTERVAL.
+type ethNodePool struct {
+	nodes  []*ethNode
+	config orm.ConfigReader
+	mutex  sync.RWMutex
+	active int
+	done   chan struct{}
+	wg     sync.WaitGroup
+}
+
+func newEthNodePool(urls []string, limiter *rate.Limiter, config orm.ConfigReader) (*ethNodePool, error) {
+	var nodes []*ethNode
+	for _, u := range urls {
+		wrapper, err := newLazyRPCWrapperForScheme(u, limiter)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, newEthNode(wrapper))
+	}
+	return &ethNodePool{
+		nodes:  nodes,
+		config: config,
+		done:   make(chan struct{}),
+	}, nil
+}
+
+// Start checks the health of the nodes in the background.
+func (p *ethNodePool) Start() error {
+	p.wg.Add(1)
+	go p.checkHealthLoop()
+	return nil
+}
+
+// Close stops checking the health of the nodes.
+func (p *ethNodePool) Close() error {
+	close(p.done)
+	p.wg.Wait()
+	return nil
+}
+
+// Call calls the active node, falling back to the other healthy nodes in turn
+// if it cannot be reached. Transactions are sent to every healthy node when
+// ETH_BROADCAST_TXS is set.
+func (p *ethNodePool) Call(result interface{}, method string, args ...interface{}) error {
+	if method == "eth_sendRawTransaction" && p.config.EthBroadcastTxs() {
+		return p.broadcast(result, method, args...)
+	}
+
+	var err error
+	for _, node := range p.candidates() {
+		err = node.call(result, method, args...)
+		if !isNodeFailure(err) {
+			return err
+		}
+		logger.Warnw("Unable to reach ethereum node", "node", node.name, "method", method, "error", err)
+	}
+	return err
+}
+
+// BatchCall sends a batch of calls to the active node, falling back to the
+// other healthy nodes in turn if it cannot be reached.
+func (p *ethNodePool) BatchCall(b []rpc.BatchElem) error {
+	var err error
+	for _, node := range p.candidates() {
+		err = node.batchCall(b)
+		if !isNodeFailure(err) {
+			return err
+		}
+		logger.Warnw("Unable to reach ethereum node", "node", node.name, "method", "batch", "error", err)
+	}
+	return err
+}
+
+// Subscribe subscribes through the active node, falling back to the other
+// healthy nodes in turn if it cannot be reached. The subscription errors with
+// errFailover if the pool fails over from the node it was made through.
+func (p *ethNodePool) Subscribe(ctx context.Context, channel interface{}, args ...interface{}) (eth.Subscription, error) {
+	var err error
+	for _, node := range p.candidates() {
+		var sub eth.Subscription
+		sub, err = node.rpc.Subscribe(ctx, channel, args...)
+		if err == nil {
+			return node.track(sub), nil
+		}
+		logger.Warnw("Unable to subscribe through ethereum node", "node", node.name, "error", err)
+	}
+	return nil, err
+}
+
+// broadcast sends a call to every healthy node at once, returning the result
+// of the active node unless it could not be reached.
+func (p *ethNodePool) broadcast(result interface{}, method string, args ...interface{}) error {
+	candidates := p.candidates()
+	results := make([]json.RawMessage, len(candidates))
+	errs := make([]error, len(candidates))
+
+	var wg sync.WaitGroup
+	for i, node := range candidates {
+		wg.Add(1)
+		go func(i int, node *ethNode) {
+			defer wg.Done()
+			errs[i] = node.call(&results[i], method, args...)
+		}(i, node)
+	}
+	wg.Wait()
+
+	for i, node := range candidates {
+		if isNodeFailure(errs[i]) {
+			logger.Warnw("Unable to reach ethereum node", "node", node.name, "method", method, "error", errs[i])
+			continue
+		}
+		if errs[i] != nil {
+			return errs[i]
+		}
+		return json.Unmarshal(results[i], result)
+	}
+	return errs[0]
+}
+
+// candidates returns the active node followed by the other healthy nodes, in
+// the order they are tried in.
+func (p *ethNodePool) candidates() []*ethNode {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	candidates := []*ethNode{p.nodes[p.active]}
+	for i, node := range p.nodes {
+		if i != p.active && node.healthy.IsSet() {
+			candidates = append(candidates, node)
+		}
+	}
+	return candidates
+}
+
+func (p *ethNodePool) checkHealthLoop() {
+	defer p.wg.Done()
+
+	p.checkHealth()
+	ticker := time.NewTicker(p.config.EthHealthCheckInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			p.checkHealth()
+		case <-p.done:
+			return
+		}
+	}
+}
+
+// checkHealth marks each node healthy or unhealthy, and fails over to the
+// first healthy node if it is not already active. A node is unhealthy if it
+// cannot be reached, does not respond within ETH_HEALTH_CHECK_INTERVAL, is on
+// the wrong chain, lags more than ETH_MAX_HEAD_LAG blocks behind the highest
+// head of the nodes, or failed more than ETH_MAX_ERROR_RATE of the calls made
+// to it since the last check. The nodes are checked at once, so that one that
+// does not respond holds up neither the others nor the next check.
+func (p *ethNodePool) checkHealth() {
+	heads := make([]uint64, len(p.nodes))
+	errs := make([]error, len(p.nodes))
+
+	ctx, cancel := context.WithTimeout(context.Background(), p.config.EthHealthCheckInterval())
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for i, node := range p.nodes {
+		wg.Add(1)
+		go func(i int, node *ethNode) {
+			defer wg.Done()
+			heads[i], errs[i] = p.checkNode(ctx, node)
+		}(i, node)
+	}
+	wg.Wait()
+
+	var highest uint64
+	for i := range p.nodes {
+		if errs[i] == nil && heads[i] > highest {
+			highest = heads[i]
+		}
+	}
+
+	for i, node := range p.nodes {
+		if errs[i] == nil && highest-heads[i] > p.config.EthMaxHeadLag() {
+			errs[i] = fmt.Errorf("head %d is %d blocks behind the highest head %d", heads[i], highest-heads[i], highest)
+		}
+		node.setHealth(errs[i])
+	}
+
+	p.selectActive()
+}
+
+func (p *ethNodePool) checkNode(ctx context.Context, node *ethNode) (uint64, error) {
+	calls := atomic.SwapUint64(&node.calls, 0)
+	failures := atomic.SwapUint64(&node.failures, 0)
+
+	var chainID utils.Big
+	if err := node.rpc.CallContext(ctx, &chainID, "eth_chainId"); err != nil {
+		return 0, errors.Wrap(err, "eth_chainId")
+	} else if chainID.ToInt().Cmp(p.config.ChainID()) != 0 {
+		return 0, fmt.Errorf("chain ID %s does not match ETH_CHAIN_ID %s", chainID.String(), p.config.ChainID())
+	}
+
+	var head hexutil.Uint64
+	if err := node.rpc.CallContext(ctx, &head, "eth_blockNumber"); err != nil {
+		return 0, errors.Wrap(err, "eth_blockNumber")
+	}
+	numberEthNodeHead.WithLabelValues(node.name).Set(float64(head))
+
+	if calls >= minCallsForErrorRate && float64(failures)/float64(calls) > p.config.EthMaxErrorRate() {
+		return uint64(head), fmt.Errorf("%d of %d calls failed since the last health check", failures, calls)
+	}
+	return uint64(head), nil
+}
+
+// selectActive makes the first healthy node the active node, preferring the
+// primary node whenever it is healthy. The active node is kept if none are
+// healthy.
+func (p *ethNodePool) selectActive() {
+	p.mutex.Lock()
+	next := p.active
+	for i, node := range p.nodes {
+		if node.healthy.IsSet() {
+			next = i
+			break
+		}
+	}
+	if next == p.active {
+		p.mutex.Unlock()
+		if !p.nodes[next].healthy.IsSet() {
+			logger.Errorw("No healthy ethereum nodes", "node", p.nodes[next].name)
+		}
+		return
+	}
+	previous := p.nodes[p.active]
+	p.active = next
+	p.mutex.Unlock()
+
+	numberEthNodeFailovers.Inc()
+	logger.Warnw(
+		fmt.Sprintf("Failing over from ethereum node %s to %s", previous.name, p.nodes[next].name),
+		"previousNode", previous.name,
+		"node", p.nodes[next].name,
+	)
+	previous.failSubscriptions()
+}
+
+// ethNode is one of the ethereum nodes in an ethNodePool.
+type ethNode struct {
+	name     string
+	rpc      *lazyRPCWrapper
+	healthy  *abool.AtomicBool
+	calls    uint64
+	failures uint64
+
+	subscriptionsMutex sync.Mutex
+	subscriptions      map[*nodeSubscription]struct{}
+}
+
+func newEthNode(wrapper *lazyRPCWrapper) *ethNode {
+	node := &ethNode{
+		name:          redactURL(wrapper.url),
+		rpc:           wrapper,
+		healthy:       abool.NewBool(true),
+		subscriptions: make(map[*nodeSubscription]struct{}),
+	}
+	numberEthNodeHealthy.WithLabelValues(node.name).Set(1)
+	return node
+}
+
+// redactURL leaves out the path and credentials of a node's URL, which often
+// hold an API key, so that it can be logged and used as a metric label.
+func redactURL(u *url.URL) string {
+	return fmt.Sprintf("%s://%s", u.Scheme, u.Host)
+}
+
+func (n *ethNode) call(result interface{}, method string, args ...interface{}) error {
+	atomic.AddUint64(&n.calls, 1)
+	numberEthNodeCalls.WithLabelValues(n.name).Inc()
+
+	err := n.rpc.Call(result, method, args...)
+	if isNodeFailure(err) {
+		atomic.AddUint64(&n.failures, 1)
+		numberEthNodeCallErrors.WithLabelValues(n.name).Inc()
+	}
+	return err
+}
+
+func (n *ethNode) batchCall(b []rpc.BatchElem) error {
+	atomic.AddUint64(&n.calls, 1)
+	numberEthNodeCalls.WithLabelValues(n.name).Inc()
+
+	err := n.rpc.BatchCall(b)
+	if isNodeFailure(err) {
+		atomic.AddUint64(&n.failures, 1)
+		numberEthNodeCallErrors.WithLabelValues(n.name).Inc()
+	}
+	return err
+}
+
+// isNodeFailure returns true if a call failed without the node responding,
+// as opposed to the node responding with an error.
+func isNodeFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	_, responded := err.(rpc.Error)
+	return !responded
+}
+
+func (n *ethNode) setHealth(err error) {
+	if err == nil {
+		numberEthNodeHealthy.WithLabelValues(n.name).Set(1)
+		if n.healthy.SetToIf(false, true) {
+			logger.Infow(fmt.Sprintf("Ethereum node %s is healthy again", n.name), "node", n.name)
+		}
+		return
+	}
+
+	numberEthNodeHealthy.WithLabelValues(n.name).Set(0)
+	if n.healthy.SetToIf(true, false) {
+		logger.Warnw(fmt.Sprintf("Ethereum node %s is unhealthy", n.name), "node", n.name, "error", err)
+	}
+}
+
+func (n *ethNode) track(sub eth.Subscription) *nodeSubscription {
+	ns := &nodeSubscription{
+		Subscription: sub,
+		node:         n,
+		err:          make(chan error, 1),
+		failed:       make(chan struct{}),
+		done:         make(chan struct{}),
+	}
+
+	n.subscriptionsMutex.Lock()
+	n.subscriptions[ns] = struct{}{}
+	n.subscriptionsMutex.Unlock()
+
+	go ns.forwardErr()
+	return ns
+}
+
+func (n *ethNode) untrack(ns *nodeSubscription) {
+	n.subscriptionsMutex.Lock()
+	defer n.subscriptionsMutex.Unlock()
+	delete(n.subscriptions, ns)
+}
+
+func (n *ethNode) failSubscriptions() {
+	n.subscriptionsMutex.Lock()
+	defer n.subscriptionsMutex.Unlock()
+	for ns := range n.subscriptions {
+		ns.fail()
+	}
+}
+
+// nodeSubscription is a subscription made through one of the nodes of an
+// ethNodePool, which errors with errFailover when the pool fails over from
+// that node.
+type nodeSubscription struct {
+	eth.Subscription
+	node      *ethNode
+	err       chan error
+	failed    chan struct{}
+	failOnce  sync.Once
+	done      chan struct{}
+	unsubOnce sync.Once
+}
+
+// Err returns the subscription's error channel, which is closed once the
+// subscription ends.
+func (ns *nodeSubscription) Err() <-chan error {
+	return ns.err
+}
+
+// Unsubscribe ends the subscription.
+func (ns *nodeSubscription) Unsubscribe() {
+	ns.unsubOnce.Do(func() {
+		ns.node.untrack(ns)
+		close(ns.done)
+		ns.Subscription.Unsubscribe()
+	})
+}
+
+func (ns *nodeSubscription) fail() {
+	ns.failOnce.Do(func() { close(ns.failed) })
+}
+
+func (ns *nodeSubscription) forwardErr() {
+	defer close(ns.err)
+
+	select {
+	case err, open := <-ns.Subscription.Err():
+		if open && err != nil {
+			ns.err <- err
+		}
+	case <-ns.failed:
+		ns.err <- errFailover
+		go ns.Unsubscribe()
+	case <-ns.done:
+	}
+}
@@ -0,0 +1,233 @@
+package store
+
+import (
+	"math/big"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"chainlink/core/store/orm"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+type fakeEthService struct {
+	chainID int64
+	head    uint64
+}
+
+func (s *fakeEthService) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(s.chainID))
+}
+
+func (s *fakeEthService) BlockNumber() hexutil.Uint64 {
+	return hexutil.Uint64(atomic.LoadUint64(&s.head))
+}
+
+func newFakeEthNode(t *testing.T, chainID int64, head uint64) (*fakeEthService, string, func()) {
+	t.Helper()
+
+	service := &fakeEthService{chainID: chainID, head: head}
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("eth", service))
+	ts := httptest.NewServer(server.WebsocketHandler([]string{"*"}))
+	return service, "ws" + strings.TrimPrefix(ts.URL, "http"), func() {
+		ts.Close()
+		server.Stop()
+	}
+}
+
+// hangingEthService never responds to a call until it is released.
+type hangingEthService struct {
+	release chan struct{}
+}
+
+func (s *hangingEthService) ChainId() *hexutil.Big {
+	<-s.release
+	return (*hexutil.Big)(big.NewInt(3))
+}
+
+func (s *hangingEthService) BlockNumber() hexutil.Uint64 {
+	<-s.release
+	return 0
+}
+
+func newHangingEthNode(t *testing.T) (string, func()) {
+	t.Helper()
+
+	service := &hangingEthService{release: make(chan struct{})}
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("eth", service))
+	ts := httptest.NewServer(server.WebsocketHandler([]string{"*"}))
+	return "ws" + strings.TrimPrefix(ts.URL, "http"), func() {
+		close(service.release)
+		ts.Close()
+		server.Stop()
+	}
+}
+
+func newTestEthNodePool(t *testing.T, urls ...string) *ethNodePool {
+	t.Helper()
+
+	config := orm.NewConfig()
+	config.Set("ETH_CHAIN_ID", 3)
+	pool, err := newEthNodePool(urls, rate.NewLimiter(rate.Inf, 1), config)
+	require.NoError(t, err)
+	return pool
+}
+
+func TestEthNodePool_CheckHealth_HeadLag(t *testing.T) {
+	t.Parallel()
+
+	primary, primaryURL, cleanup := newFakeEthNode(t, 3, 50)
+	defer cleanup()
+	_, secondaryURL, cleanup := newFakeEthNode(t, 3, 100)
+	defer cleanup()
+
+	pool := newTestEthNodePool(t, primaryURL, secondaryURL)
+
+	pool.checkHealth()
+	assert.False(t, pool.nodes[0].healthy.IsSet())
+	assert.Equal(t, 1, pool.active)
+
+	var head hexutil.Uint64
+	require.NoError(t, pool.Call(&head, "eth_blockNumber"))
+	assert.Equal(t, hexutil.Uint64(100), head)
+
+	atomic.StoreUint64(&primary.head, 95)
+	pool.checkHealth()
+	assert.True(t, pool.nodes[0].healthy.IsSet())
+	assert.Equal(t, 0, pool.active, "should fail back to the primary node once it catches up")
+}
+
+func TestEthNodePool_CheckHealth_ChainID(t *testing.T) {
+	t.Parallel()
+
+	_, primaryURL, cleanup := newFakeEthNode(t, 1, 100)
+	defer cleanup()
+	_, secondaryURL, cleanup := newFakeEthNode(t, 3, 100)
+	defer cleanup()
+
+	pool := newTestEthNodePool(t, primaryURL, secondaryURL)
+
+	pool.checkHealth()
+	assert.False(t, pool.nodes[0].healthy.IsSet())
+	assert.True(t, pool.nodes[1].healthy.IsSet())
+	assert.Equal(t, 1, pool.active)
+}
+
+func TestEthNodePool_CheckHealth_NoHealthyNodes(t *testing.T) {
+	t.Parallel()
+
+	_, primaryURL, cleanup := newFakeEthNode(t, 1, 100)
+	defer cleanup()
+	_, secondaryURL, cleanup := newFakeEthNode(t, 1, 100)
+	defer cleanup()
+
+	pool := newTestEthNodePool(t, primaryURL, secondaryURL)
+
+	pool.checkHealth()
+	assert.Equal(t, 0, pool.active)
+}
+
+func TestEthNodePool_CheckHealth_UnresponsiveNode(t *testing.T) {
+	t.Parallel()
+
+	primaryURL, cleanup := newHangingEthNode(t)
+	defer cleanup()
+	_, secondaryURL, cleanup := newFakeEthNode(t, 3, 100)
+	defer cleanup()
+
+	pool := newTestEthNodePool(t, primaryURL, secondaryURL)
+	pool.config.(*orm.Config).Set("ETH_HEALTH_CHECK_INTERVAL", 100*time.Millisecond)
+
+	checked := make(chan struct{})
+	go func() {
+		pool.checkHealth()
+		close(checked)
+	}()
+	select {
+	case <-checked:
+	case <-time.After(5 * time.Second):
+		t.Fatal("health check held up by a node that does not respond")
+	}
+
+	assert.False(t, pool.nodes[0].healthy.IsSet())
+	assert.True(t, pool.nodes[1].healthy.IsSet())
+	assert.Equal(t, 1, pool.active)
+}
+
+func TestEthNodePool_Call_FallsBackToHealthyNodes(t *testing.T) {
+	t.Parallel()
+
+	_, primaryURL, cleanup := newFakeEthNode(t, 3, 100)
+	cleanup()
+	_, secondaryURL, cleanup := newFakeEthNode(t, 3, 101)
+	defer cleanup()
+
+	pool := newTestEthNodePool(t, primaryURL, secondaryURL)
+
+	var head hexutil.Uint64
+	require.NoError(t, pool.Call(&head, "eth_blockNumber"))
+	assert.Equal(t, hexutil.Uint64(101), head)
+	assert.Equal(t, uint64(1), atomic.LoadUint64(&pool.nodes[0].failures))
+}
+
+func TestEthNodePool_Call_RPCErrorsAreReturned(t *testing.T) {
+	t.Parallel()
+
+	_, primaryURL, cleanup := newFakeEthNode(t, 3, 100)
+	defer cleanup()
+	_, secondaryURL, cleanup := newFakeEthNode(t, 3, 100)
+	defer cleanup()
+
+	pool := newTestEthNodePool(t, primaryURL, secondaryURL)
+
+	err := pool.Call(nil, "eth_unsupported")
+	assert.Error(t, err)
+	assert.False(t, isNodeFailure(err))
+	assert.Equal(t, uint64(0), atomic.LoadUint64(&pool.nodes[0].failures))
+	assert.Equal(t, uint64(0), atomic.LoadUint64(&pool.nodes[1].calls))
+}
+
+type fakeSubscription struct {
+	err          chan error
+	unsubscribed chan struct{}
+}
+
+func (s *fakeSubscription) Err() <-chan error { return s.err }
+func (s *fakeSubscription) Unsubscribe()      { close(s.unsubscribed) }
+
+func TestEthNode_FailSubscriptions(t *testing.T) {
+	t.Parallel()
+
+	wrapper, err := newLazyRPCWrapper("ws://localhost:8546", rate.NewLimiter(rate.Inf, 1))
+	require.NoError(t, err)
+	node := newEthNode(wrapper)
+
+	inner := &fakeSubscription{err: make(chan error), unsubscribed: make(chan struct{})}
+	sub := node.track(inner)
+
+	node.failSubscriptions()
+
+	select {
+	case err := <-sub.Err():
+		assert.Equal(t, errFailover, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("subscription did not error on failover")
+	}
+	select {
+	case <-inner.unsubscribed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("subscription was not unsubscribed on failover")
+	}
+	_, open := <-sub.Err()
+	assert.False(t, open)
+	sub.Unsubscribe()
+}
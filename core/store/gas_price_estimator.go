@@ -0,0 +1,105 @@
+package store
+
+import (
+	"math/big"
+	"sort"
+	"sync"
+
+	"chainlink/core/eth"
+	"chainlink/core/logger"
+	"chainlink/core/store/models"
+	"chainlink/core/store/orm"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// GasPriceEstimator estimates the gas price for new transactions from the
+// gas prices of the transactions in recent blocks, sampling each new head.
+type GasPriceEstimator struct {
+	client  eth.Client
+	config  orm.ConfigReader
+	mutex   sync.RWMutex
+	samples [][]*big.Int
+}
+
+// NewGasPriceEstimator returns a GasPriceEstimator with no samples, which
+// estimates EthGasPriceDefault until it has seen a block.
+func NewGasPriceEstimator(client eth.Client, config orm.ConfigReader) *GasPriceEstimator {
+	return &GasPriceEstimator{client: client, config: config}
+}
+
+// OnNewHead samples the gas prices of the transactions in the new head's
+// block, dropping the samples of blocks older than EthGasPriceBlockHistory.
+func (gpe *GasPriceEstimator) OnNewHead(head *models.Head) {
+	history := gpe.config.EthGasPriceBlockHistory()
+	if history == 0 {
+		return
+	}
+
+	block, err := gpe.client.GetBlockWithTransactions(hexutil.EncodeBig(head.ToInt()))
+	if err != nil {
+		logger.Warnw("Unable to fetch block to estimate gas price", "blockNumber", head.Number, "error", err)
+		return
+	}
+
+	// Zero priced transactions, such as those of miners, are not sampled as
+	// they say nothing of the price transactions are mined at.
+	prices := []*big.Int{}
+	for _, tx := range block.Transactions {
+		if price := tx.GasPrice.ToInt(); price.Sign() > 0 {
+			prices = append(prices, price)
+		}
+	}
+
+	gpe.mutex.Lock()
+	defer gpe.mutex.Unlock()
+	gpe.samples = append(gpe.samples, prices)
+	if uint64(len(gpe.samples)) > history {
+		gpe.samples = gpe.samples[uint64(len(gpe.samples))-history:]
+	}
+}
+
+// GasPrice returns the EthGasPricePercentile percentile of the sampled gas
+// prices, but no less than EthMinGasPriceWei, or EthGasPriceDefault if there
+// are none. Either way it is capped at EthMaxGasPriceWei.
+func (gpe *GasPriceEstimator) GasPrice() *big.Int {
+	gpe.mutex.RLock()
+	var prices []*big.Int
+	for _, sample := range gpe.samples {
+		prices = append(prices, sample...)
+	}
+	gpe.mutex.RUnlock()
+
+	price := gpe.config.EthGasPriceDefault()
+	if len(prices) > 0 && gpe.config.EthGasPriceBlockHistory() > 0 {
+		price = percentile(prices, gpe.config.EthGasPricePercentile())
+		if min := gpe.config.EthMinGasPriceWei(); price.Cmp(min) < 0 {
+			price = min
+		}
+	}
+	return capGasPrice(price, gpe.config)
+}
+
+// percentile returns the value below which the given percentage of the values
+// fall, using the nearest rank.
+func percentile(values []*big.Int, percent uint64) *big.Int {
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].Cmp(values[j]) < 0
+	})
+	if percent > 100 {
+		percent = 100
+	}
+	rank := (uint64(len(values))*percent + 99) / 100
+	if rank == 0 {
+		rank = 1
+	}
+	return values[rank-1]
+}
+
+// capGasPrice returns the gas price, or EthMaxGasPriceWei if it is higher.
+func capGasPrice(gasPriceWei *big.Int, config orm.ConfigReader) *big.Int {
+	if max := config.EthMaxGasPriceWei(); gasPriceWei.Cmp(max) > 0 {
+		return max
+	}
+	return gasPriceWei
+}
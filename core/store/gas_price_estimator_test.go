@@ -0,0 +1,101 @@
+package store_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"chainlink/core/eth"
+	"chainlink/core/internal/cltest"
+	"chainlink/core/internal/mocks"
+	strpkg "chainlink/core/store"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func blockWithGasPrices(prices ...int64) eth.Block {
+	block := eth.Block{}
+	for _, price := range prices {
+		block.Transactions = append(block.Transactions, eth.Transaction{GasPrice: hexutil.Big(*big.NewInt(price))})
+	}
+	return block
+}
+
+func TestGasPriceEstimator_GasPrice(t *testing.T) {
+	t.Parallel()
+
+	config := cltest.NewTestConfig(t)
+	config.Set("ETH_GAS_PRICE_DEFAULT", 20)
+	config.Set("ETH_GAS_PRICE_BLOCK_HISTORY", 2)
+	config.Set("ETH_GAS_PRICE_PERCENTILE", 60)
+	config.Set("ETH_MAX_GAS_PRICE_WEI", 1000)
+	config.Set("ETH_MIN_GAS_PRICE_WEI", 1)
+
+	ethClient := new(mocks.Client)
+	ethClient.On("GetBlockWithTransactions", "0x1").Return(blockWithGasPrices(1, 2, 3, 4, 5), nil)
+	ethClient.On("GetBlockWithTransactions", "0x2").Return(blockWithGasPrices(6, 7, 8, 9, 10), nil)
+	ethClient.On("GetBlockWithTransactions", "0x3").Return(blockWithGasPrices(11, 12, 13, 14, 15), nil)
+	ethClient.On("GetBlockWithTransactions", "0x4").Return(eth.Block{}, errors.New("node unavailable"))
+	ethClient.On("GetBlockWithTransactions", "0x5").Return(blockWithGasPrices(5000, 5000, 5000, 5000, 5000, 5000), nil)
+
+	estimator := strpkg.NewGasPriceEstimator(ethClient, config)
+	assert.Equal(t, big.NewInt(20), estimator.GasPrice(), "no samples uses the default")
+
+	estimator.OnNewHead(cltest.Head(1))
+	assert.Equal(t, big.NewInt(3), estimator.GasPrice())
+
+	estimator.OnNewHead(cltest.Head(2))
+	assert.Equal(t, big.NewInt(6), estimator.GasPrice())
+
+	estimator.OnNewHead(cltest.Head(3))
+	assert.Equal(t, big.NewInt(11), estimator.GasPrice(), "oldest block is dropped")
+
+	estimator.OnNewHead(cltest.Head(4))
+	assert.Equal(t, big.NewInt(11), estimator.GasPrice(), "unfetchable block is skipped")
+
+	estimator.OnNewHead(cltest.Head(5))
+	assert.Equal(t, big.NewInt(1000), estimator.GasPrice(), "capped at the max")
+
+	ethClient.AssertExpectations(t)
+}
+
+func TestGasPriceEstimator_Disabled(t *testing.T) {
+	t.Parallel()
+
+	config := cltest.NewTestConfig(t)
+	config.Set("ETH_GAS_PRICE_DEFAULT", 20)
+	config.Set("ETH_GAS_PRICE_BLOCK_HISTORY", 0)
+
+	ethClient := new(mocks.Client)
+	estimator := strpkg.NewGasPriceEstimator(ethClient, config)
+
+	estimator.OnNewHead(cltest.Head(1))
+	assert.Equal(t, big.NewInt(20), estimator.GasPrice())
+
+	ethClient.AssertNotCalled(t, "GetBlockWithTransactions", "0x1")
+}
+
+func TestGasPriceEstimator_MinGasPrice(t *testing.T) {
+	t.Parallel()
+
+	config := cltest.NewTestConfig(t)
+	config.Set("ETH_GAS_PRICE_DEFAULT", 20)
+	config.Set("ETH_GAS_PRICE_BLOCK_HISTORY", 2)
+	config.Set("ETH_GAS_PRICE_PERCENTILE", 60)
+	config.Set("ETH_MIN_GAS_PRICE_WEI", 5)
+
+	ethClient := new(mocks.Client)
+	ethClient.On("GetBlockWithTransactions", "0x1").Return(blockWithGasPrices(0, 0, 0), nil)
+	ethClient.On("GetBlockWithTransactions", "0x2").Return(blockWithGasPrices(0, 2, 3), nil)
+
+	estimator := strpkg.NewGasPriceEstimator(ethClient, config)
+
+	estimator.OnNewHead(cltest.Head(1))
+	assert.Equal(t, big.NewInt(20), estimator.GasPrice(), "zero priced transactions are not sampled")
+
+	estimator.OnNewHead(cltest.Head(2))
+	assert.Equal(t, big.NewInt(5), estimator.GasPrice(), "raised to the min")
+
+	ethClient.AssertExpectations(t)
+}
@@ -29,6 +29,15 @@ import (
 	"chainlink/core/store/migrations/migration1573812490"
 	"chainlink/core/store/migrations/migration1575036327"
 	"chainlink/core/store/migrations/migration1576022702"
+	"chainlink/core/store/migrations/migration1576789321"
+	"chainlink/core/store/migrations/migration1577088143"
+	"chainlink/core/store/migrations/migration1577366250"
+	"chainlink/core/store/migrations/migration1577461340"
+	"chainlink/core/store/migrations/migration1577552910"
+	"chainlink/core/store/migrations/migration1577640105"
+	"chainlink/core/store/migrations/migration1577728312"
+	"chainlink/core/store/migrations/migration1577815000"
+	"chainlink/core/store/migrations/migration1577902000"
 
 	"github.com/jinzhu/gorm"
 	"github.com/pkg/errors"
@@ -151,6 +160,42 @@ func MigrateTo(db *gorm.DB, migrationID string) error {
 			ID:      "1576022702",
 			Migrate: migration1576022702.Migrate,
 		},
+		{
+			ID:      "1576789321",
+			Migrate: migration1576789321.Migrate,
+		},
+		{
+			ID:      "1577088143",
+			Migrate: migration1577088143.Migrate,
+		},
+		{
+			ID:      "1577366250",
+			Migrate: migration1577366250.Migrate,
+		},
+		{
+			ID:      "1577461340",
+			Migrate: migration1577461340.Migrate,
+		},
+		{
+			ID:      "1577552910",
+			Migrate: migration1577552910.Migrate,
+		},
+		{
+			ID:      "1577640105",
+			Migrate: migration1577640105.Migrate,
+		},
+		{
+			ID:      "1577728312",
+			Migrate: migration1577728312.Migrate,
+		},
+		{
+			ID:      "1577815000",
+			Migrate: migration1577815000.Migrate,
+		},
+		{
+			ID:      "1577902000",
+			Migrate: migration1577902000.Migrate,
+		},
 	}
 
 	m := gormigrate.New(db, &options, migrations)
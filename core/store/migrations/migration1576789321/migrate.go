@@ -0,0 +1,25 @@
+package migration1576789321
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+type head struct {
+	ParentHash common.Hash
+}
+
+// TableName returns the table name for the heads captured in this migration
+func (head) TableName() string {
+	return "heads"
+}
+
+// Migrate adds the parent hash to heads so that chain reorganizations can be
+// detected.
+func Migrate(tx *gorm.DB) error {
+	if err := tx.AutoMigrate(&head{}).Error; err != nil {
+		return errors.Wrap(err, "could not add parent_hash to heads")
+	}
+	return nil
+}
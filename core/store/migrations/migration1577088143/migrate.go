@@ -0,0 +1,51 @@
+package migration1577088143
+
+import (
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+	null "gopkg.in/guregu/null.v3"
+)
+
+type taskSpec struct {
+	Retry string `gorm:"type:text"`
+}
+
+// TableName returns the table name for the task specs captured in this migration
+func (taskSpec) TableName() string {
+	return "task_specs"
+}
+
+type taskRun struct {
+	Attempts uint32 `gorm:"not null;default:0"`
+	RetryAt  null.Time
+}
+
+// TableName returns the table name for the task runs captured in this migration
+func (taskRun) TableName() string {
+	return "task_runs"
+}
+
+type taskRunAttempt struct {
+	ID        uint   `gorm:"primary_key;auto_increment"`
+	TaskRunID string `gorm:"index;not null;type:varchar(36) REFERENCES task_runs(id) ON DELETE CASCADE"`
+	Number    uint32
+	Error     null.String
+	CreatedAt time.Time
+}
+
+// Migrate adds retry policies to task specs and records the attempts made
+// at each task run.
+func Migrate(tx *gorm.DB) error {
+	if err := tx.AutoMigrate(&taskSpec{}).Error; err != nil {
+		return errors.Wrap(err, "could not add retry to task_specs")
+	}
+	if err := tx.AutoMigrate(&taskRun{}).Error; err != nil {
+		return errors.Wrap(err, "could not add attempts and retry_at to task_runs")
+	}
+	if err := tx.AutoMigrate(&taskRunAttempt{}).Error; err != nil {
+		return errors.Wrap(err, "could not create task_run_attempts table")
+	}
+	return nil
+}
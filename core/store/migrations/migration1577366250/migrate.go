@@ -0,0 +1,37 @@
+package migration1577366250
+
+import (
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+type taskSpec struct {
+	TaskID string `gorm:"index"`
+	Inputs string `gorm:"type:text"`
+}
+
+// TableName returns the table name for the task specs captured in this migration
+func (taskSpec) TableName() string {
+	return "task_specs"
+}
+
+type taskRun struct {
+	Inputs string `gorm:"type:text"`
+}
+
+// TableName returns the table name for the task runs captured in this migration
+func (taskRun) TableName() string {
+	return "task_runs"
+}
+
+// Migrate adds task IDs and inputs to task specs, so that the tasks of a job
+// can form a graph, and records the inputs each task run consumed.
+func Migrate(tx *gorm.DB) error {
+	if err := tx.AutoMigrate(&taskSpec{}).Error; err != nil {
+		return errors.Wrap(err, "could not add task_id and inputs to task_specs")
+	}
+	if err := tx.AutoMigrate(&taskRun{}).Error; err != nil {
+		return errors.Wrap(err, "could not add inputs to task_runs")
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package migration1577461340
+
+import (
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+type initiator struct {
+	MinimumResponses uint32 `gorm:"not null;default:0"`
+}
+
+// TableName returns the table name for the initiators captured in this migration
+func (initiator) TableName() string {
+	return "initiators"
+}
+
+type runRequest struct {
+	ContributingFeeds string `gorm:"type:text"`
+}
+
+// TableName returns the table name for the run requests captured in this migration
+func (runRequest) TableName() string {
+	return "run_requests"
+}
+
+// Migrate adds the minimum number of successful feed responses to flux
+// monitor initiators, and records the feeds contributing to each flux
+// monitor run.
+func Migrate(tx *gorm.DB) error {
+	if err := tx.AutoMigrate(&initiator{}).Error; err != nil {
+		return errors.Wrap(err, "could not add minimum_responses to initiators")
+	}
+	if err := tx.AutoMigrate(&runRequest{}).Error; err != nil {
+		return errors.Wrap(err, "could not add contributing_feeds to run_requests")
+	}
+	return nil
+}
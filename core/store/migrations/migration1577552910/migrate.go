@@ -0,0 +1,26 @@
+package migration1577552910
+
+import (
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+type initiator struct {
+	PollInterval  int64 `gorm:"not null;default:0"`
+	HTTPTimeout   int64 `gorm:"not null;default:0"`
+	IdleThreshold int64 `gorm:"not null;default:0"`
+}
+
+// TableName returns the table name for the initiators captured in this migration
+func (initiator) TableName() string {
+	return "initiators"
+}
+
+// Migrate adds the poll interval, HTTP timeout and idle threshold of flux
+// monitor initiators.
+func Migrate(tx *gorm.DB) error {
+	if err := tx.AutoMigrate(&initiator{}).Error; err != nil {
+		return errors.Wrap(err, "could not add poll_interval, http_timeout and idle_threshold to initiators")
+	}
+	return nil
+}
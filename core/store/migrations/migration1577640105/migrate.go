@@ -0,0 +1,27 @@
+package migration1577640105
+
+import (
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+type initiator struct {
+	AbsoluteThreshold *string `gorm:"type:varchar(255)"`
+	ThresholdMode     string  `gorm:"not null;default:''"`
+	MinAnswer         *string `gorm:"type:varchar(255)"`
+	MaxAnswer         *string `gorm:"type:varchar(255)"`
+}
+
+// TableName returns the table name for the initiators captured in this migration
+func (initiator) TableName() string {
+	return "initiators"
+}
+
+// Migrate adds the absolute deviation threshold, threshold mode and answer
+// bounds of flux monitor initiators.
+func Migrate(tx *gorm.DB) error {
+	if err := tx.AutoMigrate(&initiator{}).Error; err != nil {
+		return errors.Wrap(err, "could not add absolute_threshold, threshold_mode, min_answer and max_answer to initiators")
+	}
+	return nil
+}
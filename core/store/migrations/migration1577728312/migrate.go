@@ -0,0 +1,24 @@
+package migration1577728312
+
+import (
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+type tx struct {
+	GasEstimate uint64 `gorm:"not null;default:0"`
+}
+
+// TableName returns the table name for the transactions captured in this
+// migration
+func (tx) TableName() string {
+	return "txes"
+}
+
+// Migrate adds the node's gas estimate to transactions.
+func Migrate(db *gorm.DB) error {
+	if err := db.AutoMigrate(&tx{}).Error; err != nil {
+		return errors.Wrap(err, "could not add gas_estimate to txes")
+	}
+	return nil
+}
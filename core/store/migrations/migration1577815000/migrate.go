@@ -0,0 +1,23 @@
+package migration1577815000
+
+import (
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+type initiator struct {
+	FromAddresses string `gorm:"type:text"`
+}
+
+// TableName returns the table name for the initiators captured in this migration
+func (initiator) TableName() string {
+	return "initiators"
+}
+
+// Migrate adds the from addresses that flux monitor initiators submit from.
+func Migrate(tx *gorm.DB) error {
+	if err := tx.AutoMigrate(&initiator{}).Error; err != nil {
+		return errors.Wrap(err, "could not add from_addresses to initiators")
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package migration1577902000
+
+import (
+	"chainlink/core/utils"
+
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+type runRequest struct {
+	InitiatorID *uint
+	LogIndex    *uint
+}
+
+// TableName returns the table name for the run requests captured in this migration
+func (runRequest) TableName() string {
+	return "run_requests"
+}
+
+type initiator struct {
+	LastProcessedBlock *utils.Big `gorm:"type:varchar(255)"`
+}
+
+// TableName returns the table name for the initiators captured in this migration
+func (initiator) TableName() string {
+	return "initiators"
+}
+
+// Migrate identifies the log that triggered each log initiated run, so that a
+// log only triggers one run of each initiator per block it is mined in, and
+// records the last block processed by each log initiator.
+func Migrate(tx *gorm.DB) error {
+	if err := tx.AutoMigrate(&runRequest{}).Error; err != nil {
+		return errors.Wrap(err, "could not add initiator_id and log_index to run_requests")
+	}
+	if err := tx.Exec(`CREATE UNIQUE INDEX idx_run_requests_log ON run_requests ("initiator_id", "block_hash", "tx_hash", "log_index")`).Error; err != nil {
+		return errors.Wrap(err, "could not create run requests log index")
+	}
+	if err := tx.AutoMigrate(&initiator{}).Error; err != nil {
+		return errors.Wrap(err, "could not add last_processed_block to initiators")
+	}
+	return nil
+}
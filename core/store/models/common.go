@@ -38,6 +38,8 @@ const (
 	RunStatusPendingBridge = RunStatus("pending_bridge")
 	// RunStatusPendingSleep is used for when a run is waiting on a sleep function to finish.
 	RunStatusPendingSleep = RunStatus("pending_sleep")
+	// RunStatusPendingRetry is used for when a run is waiting to retry a failed task.
+	RunStatusPendingRetry = RunStatus("pending_retry")
 	// RunStatusErrored is used for when a run has errored and will not complete.
 	RunStatusErrored = RunStatus("errored")
 	// RunStatusCompleted is used for when a run has successfully completed execution.
@@ -71,6 +73,11 @@ func (s RunStatus) PendingSleep() bool {
 	return s == RunStatusPendingSleep
 }
 
+// PendingRetry returns true if the status is pending_retry.
+func (s RunStatus) PendingRetry() bool {
+	return s == RunStatusPendingRetry
+}
+
 // Completed returns true if the status is RunStatusCompleted.
 func (s RunStatus) Completed() bool {
 	return s == RunStatusCompleted
@@ -88,7 +95,7 @@ func (s RunStatus) Errored() bool {
 
 // Pending returns true if the status is pending external or confirmations.
 func (s RunStatus) Pending() bool {
-	return s.PendingBridge() || s.PendingConfirmations() || s.PendingSleep() || s.PendingConnection()
+	return s.PendingBridge() || s.PendingConfirmations() || s.PendingSleep() || s.PendingConnection() || s.PendingRetry()
 }
 
 // Finished returns true if the status is final and can't be changed.
@@ -401,6 +408,40 @@ func (c Cron) String() string {
 	return string(c)
 }
 
+// Duration is a time.Duration that is represented in JSON as a string,
+// such as "1m30s".
+type Duration time.Duration
+
+// Duration returns the value as the standard time.Duration value.
+func (d Duration) Duration() time.Duration {
+	return time.Duration(d)
+}
+
+// String returns a string representing the duration in the form "72h3m0.5s".
+func (d Duration) String() string {
+	return time.Duration(d).String()
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON parses a duration string such as "30s".
+func (d *Duration) UnmarshalJSON(input []byte) error {
+	var s string
+	if err := json.Unmarshal(input, &s); err != nil {
+		return fmt.Errorf("Duration: %v", err)
+	}
+
+	duration, err := time.ParseDuration(s)
+	if err != nil {
+		return fmt.Errorf("Duration: %v", err)
+	}
+	*d = Duration(duration)
+	return nil
+}
+
 // WithdrawalRequest request to withdraw LINK.
 type WithdrawalRequest struct {
 	DestinationAddress common.Address `json:"address"`
@@ -420,6 +461,16 @@ type CreateKeyRequest struct {
 	CurrentPassword string `json:"current_password"`
 }
 
+// ExportKeyRequest represents a request to export an ethereum key.
+type ExportKeyRequest struct {
+	CurrentPassword string `json:"current_password"`
+}
+
+// DeleteKeyRequest represents a request to delete an ethereum key.
+type DeleteKeyRequest struct {
+	CurrentPassword string `json:"current_password"`
+}
+
 // AddressCollection is an array of common.Address
 // serializable to and from a database.
 type AddressCollection []common.Address
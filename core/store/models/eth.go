@@ -7,6 +7,7 @@ import (
 	"math/big"
 	"time"
 
+	"chainlink/core/eth"
 	"chainlink/core/utils"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -36,6 +37,10 @@ type Tx struct {
 	Value    *utils.Big     `gorm:"type:varchar(78);not null"`
 	GasLimit uint64         `gorm:"not null"`
 
+	// GasEstimate is the gas the node estimated the transaction would use
+	// when it was created, or zero if it could not be estimated.
+	GasEstimate uint64 `gorm:"not null;default:0"`
+
 	// TxAttempt fields manually included; can't embed another primary_key
 	Hash        common.Hash `gorm:"not null"`
 	GasPrice    *utils.Big  `gorm:"type:varchar(78);not null"`
@@ -54,6 +59,14 @@ func (tx *Tx) String() string {
 		tx.SentAt)
 }
 
+// IsCancellation returns true if the transaction is a zero value self-send,
+// as is sent in place of a cancelled transaction.
+func (tx Tx) IsCancellation() bool {
+	return tx.To == tx.From &&
+		len(tx.Data) == 0 &&
+		(tx.Value == nil || tx.Value.ToInt().Sign() == 0)
+}
+
 // EthTx creates a new Ethereum transaction with a given gasPrice in wei
 // that is ready to be signed.
 func (tx Tx) EthTx(gasPriceWei *big.Int) *types.Transaction {
@@ -132,16 +145,21 @@ func HighestPricedTxAttemptPerTx(items []TxAttempt) []TxAttempt {
 	return highestPriced
 }
 
+// HeadsRetained is the number of most recent heads kept in the heads table,
+// and therefore the deepest chain reorganization that can be detected.
+const HeadsRetained = 100
+
 // Head represents a BlockNumber, BlockHash.
 type Head struct {
-	ID     uint64      `gorm:"primary_key;auto_increment"`
-	Hash   common.Hash `gorm:"not null"`
-	Number int64       `gorm:"index;not null"`
+	ID         uint64      `gorm:"primary_key;auto_increment"`
+	Hash       common.Hash `gorm:"not null"`
+	ParentHash common.Hash
+	Number     int64 `gorm:"index;not null"`
 }
 
 // AfterCreate is a gorm hook that trims heads after its creation
 func (h Head) AfterCreate(scope *gorm.Scope) (err error) {
-	scope.DB().Exec(`
+	scope.DB().Exec(fmt.Sprintf(`
 	DELETE FROM heads
 	WHERE id <= (
 	  SELECT id
@@ -149,9 +167,9 @@ func (h Head) AfterCreate(scope *gorm.Scope) (err error) {
 		SELECT id
 		FROM heads
 		ORDER BY id DESC
-		LIMIT 1 OFFSET 100
+		LIMIT 1 OFFSET %d
 	  ) foo
-	)`)
+	)`, HeadsRetained))
 	if err != nil {
 		return err
 	}
@@ -170,6 +188,26 @@ func NewHead(bigint *big.Int, hash common.Hash) *Head {
 	}
 }
 
+// NewHeadFromBlockHeader returns a Head instance for the passed block header,
+// including the parent hash used to detect chain reorganizations.
+func NewHeadFromBlockHeader(bh eth.BlockHeader) *Head {
+	head := NewHead(bh.Number.ToInt(), bh.Hash())
+	if head != nil {
+		head.ParentHash = bh.ParentHash
+	}
+	return head
+}
+
+// IsChildOf returns true if the receiver's parent hash is the hash of the
+// passed head. Heads without a known parent hash are assumed to extend the
+// chain.
+func (l *Head) IsChildOf(parent *Head) bool {
+	if l == nil || parent == nil || l.ParentHash == (common.Hash{}) {
+		return true
+	}
+	return l.ParentHash == parent.Hash
+}
+
 // String returns a string representation of this number.
 func (l *Head) String() string {
 	return l.ToInt().String()
@@ -88,8 +88,16 @@ func (jr JobRun) HasError() bool {
 	return jr.Status.Errored()
 }
 
-// NextTaskRunIndex returns the position of the next unfinished task
+// NextTaskRunIndex returns the position of the next unfinished task. While
+// the run is pending, that is the first task pending for the same reason.
 func (jr *JobRun) NextTaskRunIndex() (int, bool) {
+	if jr.Status.Pending() {
+		for index, tr := range jr.TaskRuns {
+			if tr.Status == jr.Status {
+				return index, true
+			}
+		}
+	}
 	for index, tr := range jr.TaskRuns {
 		if tr.Status.CanStart() {
 			return index, true
@@ -117,6 +125,54 @@ func (jr *JobRun) PreviousTaskRun() *TaskRun {
 	return nil
 }
 
+// IsTaskGraph returns true if the run's tasks form a graph, rather than
+// each task consuming the result of the one before it.
+func (jr *JobRun) IsTaskGraph() bool {
+	for _, tr := range jr.TaskRuns {
+		if len(tr.TaskSpec.Inputs) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// TaskRunInputs returns the task runs whose results are inputs of the passed
+// task run, in the order they are listed by its TaskSpec.
+func (jr *JobRun) TaskRunInputs(taskRun *TaskRun) []*TaskRun {
+	var inputs []*TaskRun
+	for _, taskID := range taskRun.TaskSpec.Inputs {
+		for i := range jr.TaskRuns {
+			if jr.TaskRuns[i].TaskSpec.TaskID == taskID {
+				inputs = append(inputs, &jr.TaskRuns[i])
+				break
+			}
+		}
+	}
+	return inputs
+}
+
+// ReadyTaskRuns returns the unfinished task runs of a task graph whose inputs
+// have all completed. Tasks waiting on a bridge or to be retried are only
+// made ready again by resuming them.
+func (jr *JobRun) ReadyTaskRuns() []*TaskRun {
+	var ready []*TaskRun
+	for i := range jr.TaskRuns {
+		taskRun := &jr.TaskRuns[i]
+		if taskRun.Status.Finished() || taskRun.Status.PendingBridge() || taskRun.Status.PendingRetry() {
+			continue
+		}
+
+		inputsComplete := true
+		for _, input := range jr.TaskRunInputs(taskRun) {
+			inputsComplete = inputsComplete && input.Status.Completed()
+		}
+		if inputsComplete {
+			ready = append(ready, taskRun)
+		}
+	}
+	return ready
+}
+
 // TasksRemain returns true if there are unfinished tasks left for this job run
 func (jr *JobRun) TasksRemain() bool {
 	_, runnable := jr.NextTaskRunIndex()
@@ -138,6 +194,13 @@ func (jr *JobRun) Cancel() {
 	jr.setStatus(RunStatusCancelled)
 }
 
+// CancelWithReason cancels this run, recording why it was cancelled in its
+// result.
+func (jr *JobRun) CancelWithReason(reason string) {
+	jr.Result.ErrorMessage = null.StringFrom(reason)
+	jr.Cancel()
+}
+
 // ApplyOutput updates the JobRun's Result and Status
 func (jr *JobRun) ApplyOutput(result RunOutput) {
 	if result.HasError() {
@@ -180,6 +243,15 @@ type RunRequest struct {
 	Requester *common.Address
 	CreatedAt time.Time
 	Payment   *assets.Link
+	// InitiatorID and LogIndex, along with BlockHash and TxHash, uniquely
+	// identify the log that triggered a log initiated run, so that a log only
+	// triggers one run of each initiator. A log mined again in another block
+	// after a chain reorganization triggers a new run.
+	InitiatorID *uint
+	LogIndex    *uint
+	// ContributingFeeds lists the feeds whose answers were aggregated into
+	// the answer submitted by a flux monitor run.
+	ContributingFeeds Feeds `gorm:"type:text"`
 }
 
 // NewRunRequest returns a new RunRequest instance.
@@ -190,16 +262,20 @@ func NewRunRequest() *RunRequest {
 // TaskRun stores the Task and represents the status of the
 // Task to be ran.
 type TaskRun struct {
-	ID                   *ID           `json:"id" gorm:"primary_key;not null"`
-	JobRunID             *ID           `json:"-" gorm:"index;not null;type:varchar(36) REFERENCES job_runs(id) ON DELETE CASCADE"`
-	Result               RunResult     `json:"result"`
-	ResultID             uint          `json:"-"`
-	Status               RunStatus     `json:"status"`
-	TaskSpec             TaskSpec      `json:"task" gorm:"association_autoupdate:false;association_autocreate:false"`
-	TaskSpecID           uint          `json:"-" gorm:"index;not null REFERENCES task_specs(id)"`
-	MinimumConfirmations clnull.Uint32 `json:"minimumConfirmations"`
-	Confirmations        clnull.Uint32 `json:"confirmations"`
-	CreatedAt            time.Time     `json:"-" gorm:"index"`
+	ID                   *ID              `json:"id" gorm:"primary_key;not null"`
+	JobRunID             *ID              `json:"-" gorm:"index;not null;type:varchar(36) REFERENCES job_runs(id) ON DELETE CASCADE"`
+	Result               RunResult        `json:"result"`
+	ResultID             uint             `json:"-"`
+	Status               RunStatus        `json:"status"`
+	TaskSpec             TaskSpec         `json:"task" gorm:"association_autoupdate:false;association_autocreate:false"`
+	TaskSpecID           uint             `json:"-" gorm:"index;not null REFERENCES task_specs(id)"`
+	MinimumConfirmations clnull.Uint32    `json:"minimumConfirmations"`
+	Confirmations        clnull.Uint32    `json:"confirmations"`
+	Attempts             uint32           `json:"attempts"`
+	AttemptHistory       []TaskRunAttempt `json:"attemptHistory"`
+	RetryAt              null.Time        `json:"retryAt"`
+	Inputs               TaskInputs       `json:"inputs,omitempty" gorm:"type:text"`
+	CreatedAt            time.Time        `json:"-" gorm:"index"`
 }
 
 // String returns info on the TaskRun as "ID,Type,Status,Result".
@@ -213,6 +289,27 @@ func (tr *TaskRun) SetError(err error) {
 	tr.Status = RunStatusErrored
 }
 
+// RecordAttempt counts an attempt at performing the task, saving its error
+// if it failed.
+func (tr *TaskRun) RecordAttempt(err error) {
+	tr.Attempts++
+	attempt := TaskRunAttempt{
+		TaskRunID: tr.ID,
+		Number:    tr.Attempts,
+		CreatedAt: time.Now(),
+	}
+	if err != nil {
+		attempt.Error = null.StringFrom(err.Error())
+	}
+	tr.AttemptHistory = append(tr.AttemptHistory, attempt)
+}
+
+// ScheduleRetry pauses the task until it is retried at the passed time.
+func (tr *TaskRun) ScheduleRetry(at time.Time) {
+	tr.Status = RunStatusPendingRetry
+	tr.RetryAt = null.TimeFrom(at)
+}
+
 // ApplyBridgeRunResult updates the TaskRun's Result and Status
 func (tr *TaskRun) ApplyBridgeRunResult(result BridgeRunResult) {
 	if result.HasError() {
@@ -225,6 +322,9 @@ func (tr *TaskRun) ApplyBridgeRunResult(result BridgeRunResult) {
 // ApplyOutput updates the TaskRun's Result and Status
 func (tr *TaskRun) ApplyOutput(result RunOutput) {
 	if result.HasError() {
+		if result.Data().Exists() {
+			tr.Result.Data = result.Data()
+		}
 		tr.SetError(result.Error())
 		return
 	}
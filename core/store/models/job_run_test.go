@@ -205,3 +205,39 @@ func TestJobRun_ApplyOutput_ErrorSetsFinishedAt(t *testing.T) {
 	jobRun.ApplyOutput(result)
 	assert.True(t, jobRun.FinishedAt.Valid)
 }
+
+func TestTaskRun_ApplyOutput_ErrorWithData(t *testing.T) {
+	t.Parallel()
+
+	taskRun := models.TaskRun{Result: models.RunResult{Data: cltest.JSONFromString(t, `{"result":"pending"}`)}}
+	taskRun.ApplyOutput(models.NewRunOutputError(errors.New("oh futz")))
+	assert.Equal(t, models.RunStatusErrored, taskRun.Status)
+	assert.Equal(t, "pending", taskRun.Result.Data.Get("result").String())
+
+	taskRun.ApplyOutput(models.NewRunOutputErrorWithData(errors.New("reverted"), cltest.JSONFromString(t, `{"result":"0x1"}`)))
+	assert.Equal(t, models.RunStatusErrored, taskRun.Status)
+	assert.Equal(t, "reverted", taskRun.Result.ErrorMessage.String)
+	assert.Equal(t, "0x1", taskRun.Result.Data.Get("result").String())
+}
+
+func TestJobRun_ReadyTaskRuns(t *testing.T) {
+	t.Parallel()
+
+	jobRun := models.JobRun{TaskRuns: []models.TaskRun{
+		{Status: models.RunStatusCompleted, TaskSpec: models.TaskSpec{TaskID: "first"}},
+		{Status: models.RunStatusUnstarted, TaskSpec: models.TaskSpec{TaskID: "second"}},
+		{Status: models.RunStatusUnstarted, TaskSpec: models.TaskSpec{TaskID: "third", Inputs: models.TaskInputs{"first"}}},
+		{Status: models.RunStatusUnstarted, TaskSpec: models.TaskSpec{TaskID: "fourth", Inputs: models.TaskInputs{"first", "second"}}},
+	}}
+	require.True(t, jobRun.IsTaskGraph())
+
+	ready := jobRun.ReadyTaskRuns()
+	require.Len(t, ready, 2)
+	assert.Equal(t, "second", ready[0].TaskSpec.TaskID)
+	assert.Equal(t, "third", ready[1].TaskSpec.TaskID)
+
+	inputs := jobRun.TaskRunInputs(&jobRun.TaskRuns[3])
+	require.Len(t, inputs, 2)
+	assert.Equal(t, "first", inputs[0].TaskSpec.TaskID)
+	assert.Equal(t, "second", inputs[1].TaskSpec.TaskID)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/jinzhu/gorm"
 	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
 	null "gopkg.in/guregu/null.v3"
 )
 
@@ -36,9 +37,12 @@ type InitiatorRequest struct {
 
 // TaskSpecRequest represents a schema for incoming TaskSpec requests as used by the API.
 type TaskSpecRequest struct {
+	TaskID        string        `json:"id,omitempty"`
 	Type          TaskType      `json:"type"`
 	Confirmations clnull.Uint32 `json:"confirmations"`
 	Params        JSON          `json:"params"`
+	Retry         *TaskRetry    `json:"retry,omitempty"`
+	Inputs        TaskInputs    `json:"inputs,omitempty"`
 }
 
 // JobSpec is the definition for all the work to be carried out by the node
@@ -93,6 +97,9 @@ func NewJobFromRequest(jsr JobSpecRequest) JobSpec {
 			Type:          task.Type,
 			Confirmations: task.Confirmations,
 			Params:        task.Params,
+			Retry:         task.Retry,
+			TaskID:        task.TaskID,
+			Inputs:        task.Inputs,
 		})
 	}
 
@@ -197,6 +204,9 @@ type Initiator struct {
 	CreatedAt       time.Time `gorm:"index"`
 	InitiatorParams `json:"params,omitempty"`
 	DeletedAt       null.Time `json:"-" gorm:"index"`
+	// LastProcessedBlock is the block of the last log processed by a log
+	// initiator, from which its subscription resumes.
+	LastProcessedBlock *utils.Big `json:"-" gorm:"type:varchar(255)"`
 }
 
 // InitiatorParams is a collection of the possible parameters that different
@@ -213,10 +223,53 @@ type InitiatorParams struct {
 	ToBlock    *utils.Big        `json:"toBlock,omitempty" gorm:"type:varchar(255)"`
 	Topics     Topics            `json:"topics,omitempty" gorm:"type:text"`
 
-	RequestData JSON    `json:"requestData,omitempty" gorm:"type:text"`
-	Feeds       Feeds   `json:"feeds,omitempty" gorm:"type:text"`
-	Threshold   float32 `json:"threshold,omitempty" gorm:"type:float"`
-	Precision   int32   `json:"precision,omitempty" gorm:"type:smallint"`
+	RequestData      JSON     `json:"requestData,omitempty" gorm:"type:text"`
+	Feeds            Feeds    `json:"feeds,omitempty" gorm:"type:text"`
+	Threshold        float32  `json:"threshold,omitempty" gorm:"type:float"`
+	Precision        int32    `json:"precision,omitempty" gorm:"type:smallint"`
+	MinimumResponses uint32   `json:"minimumResponses,omitempty"`
+	PollInterval     Duration `json:"pollInterval,omitempty"`
+	HTTPTimeout      Duration `json:"httpTimeout,omitempty"`
+	IdleThreshold    Duration `json:"idleThreshold,omitempty"`
+
+	AbsoluteThreshold *decimal.Decimal `json:"absoluteThreshold,omitempty" gorm:"type:varchar(255)"`
+	ThresholdMode     ThresholdMode    `json:"thresholdMode,omitempty"`
+	MinAnswer         *decimal.Decimal `json:"minAnswer,omitempty" gorm:"type:varchar(255)"`
+	MaxAnswer         *decimal.Decimal `json:"maxAnswer,omitempty" gorm:"type:varchar(255)"`
+
+	FromAddresses AddressCollection `json:"fromAddresses,omitempty" gorm:"type:text"`
+}
+
+// ThresholdMode defines how a flux monitor combines its relative and absolute
+// deviation thresholds, when both are set.
+type ThresholdMode string
+
+const (
+	// ThresholdModeOr starts a new round when either threshold is met. It is
+	// the default.
+	ThresholdModeOr = ThresholdMode("or")
+	// ThresholdModeAnd starts a new round only when both thresholds are met.
+	ThresholdModeAnd = ThresholdMode("and")
+)
+
+// FluxMonitorStatus is a snapshot of what the flux monitor is doing for one
+// of a job's flux monitor initiators.
+type FluxMonitorStatus struct {
+	InitiatorID uint           `json:"initiatorId"`
+	Address     common.Address `json:"address"`
+	// Connected is true while the flux monitor is connected to the Ethereum
+	// node and checking prices.
+	Connected bool `json:"connected"`
+	// CurrentPrice and CurrentRound are the on-chain answer and round last
+	// seen or submitted.
+	CurrentPrice decimal.Decimal `json:"currentPrice"`
+	CurrentRound *utils.Big      `json:"currentRound"`
+	// LastFetchedPrice is the median of the feeds as of LastPolledAt.
+	LastFetchedPrice *decimal.Decimal `json:"lastFetchedPrice"`
+	LastPolledAt     *time.Time       `json:"lastPolledAt"`
+	LastSubmittedAt  *time.Time       `json:"lastSubmittedAt"`
+	LastError        string           `json:"lastError,omitempty"`
+	LastErrorAt      *time.Time       `json:"lastErrorAt"`
 }
 
 // Topics handle the serialization of ethereum log topics to and from the data store.
@@ -246,6 +299,35 @@ func (t Topics) Value() (driver.Value, error) {
 	return string(j), nil
 }
 
+// TaskInputs holds the IDs of the tasks whose results are passed to a task,
+// serializing into the db as a JSON array.
+type TaskInputs []string
+
+// Scan populates the current TaskInputs value with the passed in value,
+// usually a string from an underlying database.
+func (ti *TaskInputs) Scan(value interface{}) error {
+	if value == nil {
+		*ti = nil
+		return nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("Unable to convert %v of %T to TaskInputs", value, value)
+	}
+
+	return json.Unmarshal([]byte(str), (*[]string)(ti))
+}
+
+// Value returns this instance serialized for database storage.
+func (ti TaskInputs) Value() (driver.Value, error) {
+	if len(ti) == 0 {
+		return nil, nil
+	}
+
+	bytes, err := json.Marshal([]string(ti))
+	return string(bytes), err
+}
+
 // Feeds holds all flux monitor feed URLs, serializing into the db
 // with ; delimited strings.
 type Feeds []string
@@ -327,12 +409,20 @@ func (i Initiator) IsLogInitiated() bool {
 // TaskSpec is the definition of work to be carried out. The
 // Type will be an adapter, and the Params will contain any
 // additional information that adapter would need to operate.
+//
+// By default each task receives the result of the task before it. If any
+// task of a job lists Inputs, the tasks instead form a graph: each task
+// receives the results of the tasks whose TaskID it lists as Inputs, and
+// tasks whose inputs are all complete are performed concurrently.
 type TaskSpec struct {
 	gorm.Model
 	JobSpecID     *ID           `json:"-"`
+	TaskID        string        `json:"id,omitempty"`
 	Type          TaskType      `json:"type" gorm:"index;not null"`
 	Confirmations clnull.Uint32 `json:"confirmations"`
 	Params        JSON          `json:"params" gorm:"type:text"`
+	Retry         *TaskRetry    `json:"retry,omitempty" gorm:"type:text"`
+	Inputs        TaskInputs    `json:"inputs,omitempty" gorm:"type:text"`
 }
 
 // TaskType defines what Adapter a TaskSpec will use.
@@ -226,9 +226,12 @@ func (le InitiatorLogEvent) BlockNumber() *big.Int {
 func (le InitiatorLogEvent) RunRequest() (RunRequest, error) {
 	txHash := common.BytesToHash(le.Log.TxHash.Bytes())
 	blockHash := common.BytesToHash(le.Log.BlockHash.Bytes())
+	initiatorID, logIndex := le.Initiator.ID, le.Log.Index
 	return RunRequest{
-		BlockHash: &blockHash,
-		TxHash:    &txHash,
+		BlockHash:   &blockHash,
+		TxHash:      &txHash,
+		InitiatorID: &initiatorID,
+		LogIndex:    &logIndex,
 	}, nil
 }
 
@@ -351,12 +354,15 @@ func (le RunLogEvent) RunRequest() (RunRequest, error) {
 	blockHash := common.BytesToHash(le.Log.BlockHash.Bytes())
 	str := parser.parseRequestID(le.Log)
 	requester := le.Requester()
+	initiatorID, logIndex := le.Initiator.ID, le.Log.Index
 	return RunRequest{
-		RequestID: &str,
-		TxHash:    &txHash,
-		BlockHash: &blockHash,
-		Requester: &requester,
-		Payment:   payment,
+		RequestID:   &str,
+		TxHash:      &txHash,
+		BlockHash:   &blockHash,
+		Requester:   &requester,
+		Payment:     payment,
+		InitiatorID: &initiatorID,
+		LogIndex:    &logIndex,
 	}, nil
 }
 
@@ -21,6 +21,16 @@ func NewRunOutputError(err error) RunOutput {
 	}
 }
 
+// NewRunOutputErrorWithData returns a new RunOutput with an error that also
+// has data recording what led to the error
+func NewRunOutputErrorWithData(err error, data JSON) RunOutput {
+	return RunOutput{
+		status: RunStatusErrored,
+		err:    err,
+		data:   data,
+	}
+}
+
 // NewRunOutputCompleteWithResult returns a new RunOutput that is complete and
 // contains a result
 func NewRunOutputCompleteWithResult(resultVal interface{}) RunOutput {
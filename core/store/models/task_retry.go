@@ -0,0 +1,131 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	null "gopkg.in/guregu/null.v3"
+)
+
+// RetryableErrorClass names a class of adapter errors that a task can be
+// retried after.
+type RetryableErrorClass string
+
+const (
+	// RetryOnHTTP5xx retries tasks that received a server error response.
+	RetryOnHTTP5xx = RetryableErrorClass("http5xx")
+	// RetryOnTimeout retries tasks whose requests timed out.
+	RetryOnTimeout = RetryableErrorClass("timeout")
+	// RetryOnBridgeConnection retries tasks that could not reach their bridge.
+	RetryOnBridgeConnection = RetryableErrorClass("bridgeConnection")
+	// RetryOnInsufficientEth retries ethtx tasks whose sending key could not
+	// afford the gas for the transaction.
+	RetryOnInsufficientEth = RetryableErrorClass("insufficientEth")
+)
+
+// RetryableErrorClasses lists all of the supported RetryableErrorClass values.
+var RetryableErrorClasses = []RetryableErrorClass{
+	RetryOnHTTP5xx,
+	RetryOnTimeout,
+	RetryOnBridgeConnection,
+	RetryOnInsufficientEth,
+}
+
+// TaskRetry is the retry policy of a TaskSpec. A failed task is attempted up
+// to MaxAttempts times in total, waiting Backoff before the first retry and
+// doubling the wait before every following retry until it exceeds an hour.
+// Only errors of one of the RetryOn classes are retried, or of any supported
+// class if none are given.
+type TaskRetry struct {
+	MaxAttempts uint32                `json:"maxAttempts"`
+	Backoff     Duration              `json:"backoff"`
+	RetryOn     []RetryableErrorClass `json:"retryOn,omitempty"`
+}
+
+// Validate returns an error if the retry policy cannot be applied.
+func (r TaskRetry) Validate() error {
+	if r.MaxAttempts < 1 {
+		return fmt.Errorf("retry maxAttempts must be at least 1")
+	}
+	if r.Backoff < 0 {
+		return fmt.Errorf("retry backoff must not be negative")
+	}
+	for _, class := range r.RetryOn {
+		if !class.supported() {
+			return fmt.Errorf("retry class %s is not supported, must be one of %v", class, RetryableErrorClasses)
+		}
+	}
+	return nil
+}
+
+// Retries returns true if an error of any of the passed classes should be
+// retried after the given number of attempts.
+func (r TaskRetry) Retries(attempts uint32, classes []RetryableErrorClass) bool {
+	if attempts >= r.MaxAttempts {
+		return false
+	}
+
+	retryOn := r.RetryOn
+	if len(retryOn) == 0 {
+		retryOn = RetryableErrorClasses
+	}
+	for _, class := range classes {
+		for _, allowed := range retryOn {
+			if class == allowed {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// BackoffAfter returns how long to wait before retrying a task that has been
+// attempted the given number of times.
+func (r TaskRetry) BackoffAfter(attempts uint32) time.Duration {
+	backoff := r.Backoff.Duration()
+	for i := uint32(1); i < attempts && backoff < time.Hour; i++ {
+		backoff *= 2
+	}
+	return backoff
+}
+
+// Value returns this instance serialized for database storage.
+func (r TaskRetry) Value() (driver.Value, error) {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// Scan reads the database value and returns an instance.
+func (r *TaskRetry) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case string:
+		return json.Unmarshal([]byte(v), r)
+	case []byte:
+		return json.Unmarshal(v, r)
+	default:
+		return fmt.Errorf("Unable to convert %v of %T to TaskRetry", value, value)
+	}
+}
+
+func (class RetryableErrorClass) supported() bool {
+	for _, supported := range RetryableErrorClasses {
+		if class == supported {
+			return true
+		}
+	}
+	return false
+}
+
+// TaskRunAttempt records the outcome of one attempt at performing a TaskRun.
+type TaskRunAttempt struct {
+	ID        uint        `json:"-" gorm:"primary_key;auto_increment"`
+	TaskRunID *ID         `json:"-" gorm:"index;not null;type:varchar(36) REFERENCES task_runs(id) ON DELETE CASCADE"`
+	Number    uint32      `json:"number"`
+	Error     null.String `json:"error"`
+	CreatedAt time.Time   `json:"createdAt"`
+}
@@ -0,0 +1,84 @@
+package models_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"chainlink/core/store/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskRetry_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var task models.TaskSpec
+	err := json.Unmarshal([]byte(`{
+		"type": "httpget",
+		"retry": {"maxAttempts": 3, "backoff": "30s", "retryOn": ["http5xx", "timeout"]}
+	}`), &task)
+	require.NoError(t, err)
+
+	require.NotNil(t, task.Retry)
+	assert.Equal(t, uint32(3), task.Retry.MaxAttempts)
+	assert.Equal(t, 30*time.Second, task.Retry.Backoff.Duration())
+	assert.Equal(t, []models.RetryableErrorClass{models.RetryOnHTTP5xx, models.RetryOnTimeout}, task.Retry.RetryOn)
+}
+
+func TestTaskRetry_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		retry   models.TaskRetry
+		wantErr bool
+	}{
+		{"valid", models.TaskRetry{MaxAttempts: 2, Backoff: models.Duration(time.Second)}, false},
+		{"no attempts", models.TaskRetry{MaxAttempts: 0}, true},
+		{"negative backoff", models.TaskRetry{MaxAttempts: 2, Backoff: models.Duration(-time.Second)}, true},
+		{"unknown class", models.TaskRetry{MaxAttempts: 2, RetryOn: []models.RetryableErrorClass{"http4xx"}}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.retry.Validate()
+			assert.Equal(t, test.wantErr, err != nil)
+		})
+	}
+}
+
+func TestTaskRetry_Retries(t *testing.T) {
+	t.Parallel()
+
+	timeout := []models.RetryableErrorClass{models.RetryOnTimeout}
+	tests := []struct {
+		name     string
+		retry    models.TaskRetry
+		attempts uint32
+		classes  []models.RetryableErrorClass
+		want     bool
+	}{
+		{"any class by default", models.TaskRetry{MaxAttempts: 2}, 1, timeout, true},
+		{"attempts exhausted", models.TaskRetry{MaxAttempts: 2}, 2, timeout, false},
+		{"unclassified error", models.TaskRetry{MaxAttempts: 2}, 1, nil, false},
+		{"class not allowed", models.TaskRetry{MaxAttempts: 2, RetryOn: []models.RetryableErrorClass{models.RetryOnHTTP5xx}}, 1, timeout, false},
+		{"class allowed", models.TaskRetry{MaxAttempts: 2, RetryOn: timeout}, 1, timeout, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, test.retry.Retries(test.attempts, test.classes))
+		})
+	}
+}
+
+func TestTaskRetry_BackoffAfter(t *testing.T) {
+	t.Parallel()
+
+	retry := models.TaskRetry{MaxAttempts: 10, Backoff: models.Duration(time.Second)}
+	assert.Equal(t, time.Second, retry.BackoffAfter(1))
+	assert.Equal(t, 2*time.Second, retry.BackoffAfter(2))
+	assert.Equal(t, 8*time.Second, retry.BackoffAfter(4))
+}
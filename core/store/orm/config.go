@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"strconv"
+	"strings"
 	"time"
 
 	"chainlink/core/assets"
@@ -101,6 +102,27 @@ func (c Config) AllowOrigins() string {
 	return c.viper.GetString(EnvVarName("AllowOrigins"))
 }
 
+// BalanceMonitorEnabled enables checking the ETH and LINK balances of the
+// node's keys on every new head.
+func (c Config) BalanceMonitorEnabled() bool {
+	return c.viper.GetBool(EnvVarName("BalanceMonitorEnabled"))
+}
+
+// BalanceWebhookURL returns the URL to notify when the ETH balance of one of
+// the node's keys falls below EthBalanceThreshold, or nil.
+func (c Config) BalanceWebhookURL() *url.URL {
+	rval := c.getWithFallback("BalanceWebhookURL", parseURL)
+	switch t := rval.(type) {
+	case nil:
+		return nil
+	case *url.URL:
+		return t
+	default:
+		logger.Panicf("invariant: BalanceWebhookURL returned as type %T", rval)
+		return nil
+	}
+}
+
 // BridgeResponseURL represents the URL for bridges to send a response to.
 func (c Config) BridgeResponseURL() *url.URL {
 	return c.getWithFallback("BridgeResponseURL", parseURL).(*url.URL)
@@ -160,18 +182,56 @@ func (c Config) MinimumServiceDuration() time.Duration {
 	return c.viper.GetDuration(EnvVarName("MinimumServiceDuration"))
 }
 
+// EthBalanceThreshold is the ETH balance below which the balance monitor
+// warns that a key is running low.
+func (c Config) EthBalanceThreshold() *assets.Eth {
+	return (*assets.Eth)(c.getWithFallback("EthBalanceThreshold", parseBigInt).(*big.Int))
+}
+
+// EthBroadcastTxs sends transactions to every healthy node in ETH_URL rather
+// than just the active one.
+func (c Config) EthBroadcastTxs() bool {
+	return c.viper.GetBool(EnvVarName("EthBroadcastTxs"))
+}
+
 // EthGasBumpThreshold represents the maximum amount a transaction's ETH amount
 // should be increased in order to facilitate a transaction.
 func (c Config) EthGasBumpThreshold() uint64 {
 	return c.viper.GetUint64(EnvVarName("EthGasBumpThreshold"))
 }
 
+// EthGasBumpPercent is the percentage by which the gas price of a transaction
+// is increased when bumping gas, if that increases it by more than
+// EthGasBumpWei.
+func (c Config) EthGasBumpPercent() uint64 {
+	return c.viper.GetUint64(EnvVarName("EthGasBumpPercent"))
+}
+
 // EthGasBumpWei represents the intervals in which ETH should be increased when
 // doing gas bumping.
 func (c Config) EthGasBumpWei() *big.Int {
 	return c.getWithFallback("EthGasBumpWei", parseBigInt).(*big.Int)
 }
 
+// EthGasEstimateMultiplier is applied to the node's gas estimate for a
+// transaction to get its gas limit, leaving a margin for state changes between
+// estimation and inclusion.
+func (c Config) EthGasEstimateMultiplier() float64 {
+	return c.viper.GetFloat64(EnvVarName("EthGasEstimateMultiplier"))
+}
+
+// EthGasLimitMax is the highest gas limit a transaction can be sent with,
+// whether estimated or given by a job.
+func (c Config) EthGasLimitMax() uint64 {
+	return c.viper.GetUint64(EnvVarName("EthGasLimitMax"))
+}
+
+// EthGasLimitMin is the lowest gas limit a transaction can be sent with,
+// whether estimated or given by a job.
+func (c Config) EthGasLimitMin() uint64 {
+	return c.viper.GetUint64(EnvVarName("EthGasLimitMin"))
+}
+
 // EthGasPriceDefault represents the default gas price for transactions.
 func (c Config) EthGasPriceDefault() *big.Int {
 	if c.runtimeStore != nil {
@@ -193,11 +253,96 @@ func (c Config) SetEthGasPriceDefault(value *big.Int) error {
 	return c.runtimeStore.SetConfigValue("EthGasPriceDefault", value)
 }
 
+// EthGasPriceBlockHistory is the number of recent blocks whose transactions
+// are sampled to estimate the gas price. Zero disables estimation, using
+// EthGasPriceDefault instead.
+func (c Config) EthGasPriceBlockHistory() uint64 {
+	return c.viper.GetUint64(EnvVarName("EthGasPriceBlockHistory"))
+}
+
+// EthGasPricePercentile is the percentile of the gas prices of recent
+// transactions used as the estimated gas price.
+func (c Config) EthGasPricePercentile() uint64 {
+	return c.viper.GetUint64(EnvVarName("EthGasPricePercentile"))
+}
+
+// EthHealthCheckInterval is how often the health of each node in ETH_URL is
+// checked.
+func (c Config) EthHealthCheckInterval() time.Duration {
+	return c.viper.GetDuration(EnvVarName("EthHealthCheckInterval"))
+}
+
+// EthHTTPURL returns the http URL of the Ethereum node to send calls to, or
+// nil to send them over the websocket of ETH_URL. Subscriptions are always
+// made through ETH_URL.
+func (c Config) EthHTTPURL() *url.URL {
+	rval := c.getWithFallback("EthHTTPURL", parseURL)
+	switch t := rval.(type) {
+	case nil:
+		return nil
+	case *url.URL:
+		return t
+	default:
+		logger.Panicf("invariant: EthHTTPURL returned as type %T", rval)
+		return nil
+	}
+}
+
+// EthMaxErrorRate is the fraction of calls to a node that can fail between
+// health checks before it is considered unhealthy.
+func (c Config) EthMaxErrorRate() float64 {
+	return c.viper.GetFloat64(EnvVarName("EthMaxErrorRate"))
+}
+
+// EthMaxGasPriceWei is the highest gas price a transaction is ever sent with,
+// whether estimated, given by a job or bumped.
+func (c Config) EthMaxGasPriceWei() *big.Int {
+	return c.getWithFallback("EthMaxGasPriceWei", parseBigInt).(*big.Int)
+}
+
+// EthMinGasPriceWei is the lowest gas price estimated for transactions, so
+// that the estimate does not fall to a price they are never mined at.
+func (c Config) EthMinGasPriceWei() *big.Int {
+	return c.getWithFallback("EthMinGasPriceWei", parseBigInt).(*big.Int)
+}
+
+// EthMaxHeadLag is how many blocks a node can fall behind the highest head of
+// the nodes in ETH_URL before it is considered unhealthy.
+func (c Config) EthMaxHeadLag() uint64 {
+	return c.viper.GetUint64(EnvVarName("EthMaxHeadLag"))
+}
+
+// EthPollingBlockRange is the most blocks whose logs are requested in a
+// single eth_getLogs call when polling for logs, see EthPollingInterval.
+func (c Config) EthPollingBlockRange() uint64 {
+	return c.viper.GetUint64(EnvVarName("EthPollingBlockRange"))
+}
+
+// EthPollingInterval is how often the ethereum node is polled for new logs
+// and heads when ETH_URL is an http URL, which cannot make subscriptions.
+func (c Config) EthPollingInterval() time.Duration {
+	return c.viper.GetDuration(EnvVarName("EthPollingInterval"))
+}
+
 // EthereumURL represents the URL of the Ethereum node to connect Chainlink to.
+// It may be a comma separated list of URLs, see EthereumURLs.
 func (c Config) EthereumURL() string {
 	return c.viper.GetString(EnvVarName("EthereumURL"))
 }
 
+// EthereumURLs returns the URLs of the Ethereum nodes to connect Chainlink to,
+// in order of preference. The first is the primary node, which is used
+// whenever it is healthy, and the rest are failed over to in turn.
+func (c Config) EthereumURLs() []string {
+	var urls []string
+	for _, u := range strings.Split(c.EthereumURL(), ",") {
+		if u = strings.TrimSpace(u); u != "" {
+			urls = append(urls, u)
+		}
+	}
+	return urls
+}
+
 // JSONConsole enables the JSON console.
 func (c Config) JSONConsole() bool {
 	return c.viper.GetBool(EnvVarName("JSONConsole"))
@@ -240,6 +385,19 @@ func (c Config) OracleContractAddress() *common.Address {
 	return c.getWithFallback("OracleContractAddress", parseAddress).(*common.Address)
 }
 
+// LogBackfillBlockWindow is the most blocks whose logs are requested in a
+// single eth_getLogs call when backfilling the logs of a log initiator.
+func (c Config) LogBackfillBlockWindow() uint64 {
+	return c.viper.GetUint64(EnvVarName("LogBackfillBlockWindow"))
+}
+
+// LogBackfillMaxRetries is how many times the lookup of a batch of log
+// backfill windows is retried, backing off in between, before the backfill
+// is given up until the node restarts.
+func (c Config) LogBackfillMaxRetries() uint64 {
+	return c.viper.GetUint64(EnvVarName("LogBackfillMaxRetries"))
+}
+
 // LogLevel represents the maximum level of log messages to output.
 func (c Config) LogLevel() LogLevel {
 	return c.getWithFallback("LogLevel", parseLogLevel).(LogLevel)
@@ -305,6 +463,25 @@ func (c Config) RootDir() string {
 	return c.getWithFallback("RootDir", parseHomeDir).(string)
 }
 
+// RunQueueMaxPending is the number of runs that can be waiting for a worker
+// before new runs are rejected, or deferred if they were initiated by a log.
+// Zero means no limit.
+func (c Config) RunQueueMaxPending() uint64 {
+	return c.viper.GetUint64(EnvVarName("RunQueueMaxPending"))
+}
+
+// RunQueueMaxWorkers is the maximum number of runs that can be executing at
+// the same time. Zero means no limit.
+func (c Config) RunQueueMaxWorkers() uint64 {
+	return c.viper.GetUint64(EnvVarName("RunQueueMaxWorkers"))
+}
+
+// RunQueueMaxWorkersPerJob is the maximum number of runs of a single job that
+// can be executing at the same time. Zero means no limit.
+func (c Config) RunQueueMaxWorkersPerJob() uint64 {
+	return c.viper.GetUint64(EnvVarName("RunQueueMaxWorkersPerJob"))
+}
+
 // SecureCookies allows toggling of the secure cookies HTTP flag
 func (c Config) SecureCookies() bool {
 	return c.viper.GetBool(EnvVarName("SecureCookies"))
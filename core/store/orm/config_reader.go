@@ -15,6 +15,8 @@ import (
 // ConfigReader represents just the read side of the config
 type ConfigReader interface {
 	AllowOrigins() string
+	BalanceMonitorEnabled() bool
+	BalanceWebhookURL() *url.URL
 	BridgeResponseURL() *url.URL
 	ChainID() *big.Int
 	ClientNodeURL() string
@@ -25,17 +27,36 @@ type ConfigReader interface {
 	FeatureExternalInitiators() bool
 	MaximumServiceDuration() time.Duration
 	MinimumServiceDuration() time.Duration
+	EthBalanceThreshold() *assets.Eth
+	EthBroadcastTxs() bool
 	EthGasBumpThreshold() uint64
+	EthGasBumpPercent() uint64
 	EthGasBumpWei() *big.Int
+	EthGasEstimateMultiplier() float64
+	EthGasLimitMax() uint64
+	EthGasLimitMin() uint64
 	EthGasPriceDefault() *big.Int
 	SetEthGasPriceDefault(value *big.Int) error
+	EthGasPriceBlockHistory() uint64
+	EthGasPricePercentile() uint64
+	EthMaxGasPriceWei() *big.Int
+	EthMinGasPriceWei() *big.Int
 	EthereumURL() string
+	EthereumURLs() []string
+	EthHealthCheckInterval() time.Duration
+	EthHTTPURL() *url.URL
+	EthMaxErrorRate() float64
+	EthMaxHeadLag() uint64
+	EthPollingBlockRange() uint64
+	EthPollingInterval() time.Duration
 	JSONConsole() bool
 	LinkContractAddress() string
 	ExplorerURL() *url.URL
 	ExplorerAccessKey() string
 	ExplorerSecret() string
 	OracleContractAddress() *common.Address
+	LogBackfillBlockWindow() uint64
+	LogBackfillMaxRetries() uint64
 	LogLevel() LogLevel
 	LogToDisk() bool
 	LogSQLStatements() bool
@@ -43,9 +64,13 @@ type ConfigReader interface {
 	MinOutgoingConfirmations() uint64
 	MinimumContractPayment() *assets.Link
 	MinimumRequestExpiration() uint64
+	MaxRPCCallsPerSecond() uint64
 	Port() uint16
 	ReaperExpiration() time.Duration
 	RootDir() string
+	RunQueueMaxPending() uint64
+	RunQueueMaxWorkers() uint64
+	RunQueueMaxWorkersPerJob() uint64
 	SecureCookies() bool
 	SessionTimeout() time.Duration
 	TLSCertPath() string
@@ -4,6 +4,7 @@ import (
 	"crypto/subtle"
 	"encoding"
 	"fmt"
+	"math/big"
 	"net/url"
 	"os"
 	"path/filepath"
@@ -253,6 +254,9 @@ func (orm *ORM) preloadJobs() *gorm.DB {
 func preloadTaskRuns(db *gorm.DB) *gorm.DB {
 	return db.
 		Preload("Result").
+		Preload("AttemptHistory", func(db *gorm.DB) *gorm.DB {
+			return db.Order("number asc")
+		}).
 		Preload("TaskSpec", func(db *gorm.DB) *gorm.DB {
 			return db.Unscoped()
 		})
@@ -278,6 +282,22 @@ func (orm *ORM) FindJobRun(id *models.ID) (models.JobRun, error) {
 	return jr, err
 }
 
+// FindJobRunForLog looks up the JobRun that the given initiator created for
+// the log at logIndex of the transaction txHash, mined in block blockHash.
+func (orm *ORM) FindJobRunForLog(initiatorID uint, blockHash, txHash common.Hash, logIndex uint) (models.JobRun, error) {
+	orm.MustEnsureAdvisoryLock()
+	var jr models.JobRun
+	err := orm.preloadJobRuns().
+		Select("job_runs.*").
+		Joins("JOIN run_requests ON run_requests.id = job_runs.run_request_id").
+		Where(
+			"run_requests.initiator_id = ? AND run_requests.block_hash = ? AND run_requests.tx_hash = ? AND run_requests.log_index = ?",
+			initiatorID, blockHash, txHash, logIndex,
+		).
+		First(&jr).Error
+	return jr, err
+}
+
 // AllSyncEvents returns all sync events
 func (orm *ORM) AllSyncEvents(cb func(*models.SyncEvent) error) error {
 	orm.MustEnsureAdvisoryLock()
@@ -603,6 +623,82 @@ func (orm *ORM) UnscopedJobRunsWithStatus(cb func(*models.JobRun), statuses ...m
 	})
 }
 
+// UnscopedJobRunsWithStatusInBlocks passes all JobRuns with one of the given
+// statuses that were requested in one of the passed blocks to a callback,
+// one by one, including those that were soft deleted.
+func (orm *ORM) UnscopedJobRunsWithStatusInBlocks(
+	cb func(*models.JobRun),
+	blockHashes []common.Hash,
+	statuses ...models.RunStatus,
+) error {
+	orm.MustEnsureAdvisoryLock()
+	if len(blockHashes) == 0 {
+		return nil
+	}
+
+	var runIDs []string
+	err := orm.db.Unscoped().
+		Table("job_runs").
+		Joins("INNER JOIN run_requests ON run_requests.id = job_runs.run_request_id").
+		Where("job_runs.status IN (?) AND run_requests.block_hash IN (?)", statuses, blockHashes).
+		Order("job_runs.created_at asc").
+		Pluck("job_runs.id", &runIDs).Error
+	if err != nil {
+		return fmt.Errorf("error finding job ids %v", err)
+	}
+
+	for _, id := range runIDs {
+		var run models.JobRun
+		err := orm.Unscoped().
+			preloadJobRuns().
+			First(&run, "job_runs.id = ?", id).Error
+		if err != nil {
+			return fmt.Errorf("error fetching job run %s: %v", id, err)
+		}
+		cb(&run)
+	}
+	return nil
+}
+
+// UnscopedJobRunsWithStatusForLog passes all JobRuns of the initiator with one
+// of the given statuses that were requested by a log of transaction txHash in
+// block blockHash to a callback, one by one, including those that were soft
+// deleted.
+func (orm *ORM) UnscopedJobRunsWithStatusForLog(
+	cb func(*models.JobRun),
+	initiatorID uint,
+	blockHash common.Hash,
+	txHash common.Hash,
+	statuses ...models.RunStatus,
+) error {
+	orm.MustEnsureAdvisoryLock()
+	var runIDs []string
+	err := orm.db.Unscoped().
+		Table("job_runs").
+		Joins("INNER JOIN run_requests ON run_requests.id = job_runs.run_request_id").
+		Where(
+			"job_runs.status IN (?) AND job_runs.initiator_id = ? AND run_requests.block_hash = ? AND run_requests.tx_hash = ?",
+			statuses, initiatorID, blockHash, txHash,
+		).
+		Order("job_runs.created_at asc").
+		Pluck("job_runs.id", &runIDs).Error
+	if err != nil {
+		return fmt.Errorf("error finding job ids %v", err)
+	}
+
+	for _, id := range runIDs {
+		var run models.JobRun
+		err := orm.Unscoped().
+			preloadJobRuns().
+			First(&run, "job_runs.id = ?", id).Error
+		if err != nil {
+			return fmt.Errorf("error fetching job run %s: %v", id, err)
+		}
+		cb(&run)
+	}
+	return nil
+}
+
 // AnyJobWithType returns true if there is at least one job associated with
 // the type name specified and false otherwise
 func (orm *ORM) AnyJobWithType(taskTypeName string) (bool, error) {
@@ -681,6 +777,23 @@ func (orm *ORM) MarkTxSafe(tx *models.Tx, txAttempt *models.TxAttempt) error {
 	return orm.db.Save(tx).Error
 }
 
+// MarkTxUnconfirmed reverts a transaction and all of its attempts to the
+// unconfirmed state, for example after the block it was mined in has been
+// orphaned.
+func (orm *ORM) MarkTxUnconfirmed(tx *models.Tx) error {
+	orm.MustEnsureAdvisoryLock()
+	return orm.convenientTransaction(func(dbtx *gorm.DB) error {
+		tx.Confirmed = false
+		for _, attempt := range tx.Attempts {
+			attempt.Confirmed = false
+		}
+		if err := dbtx.Model(&models.TxAttempt{}).Where("tx_id = ?", tx.ID).Update("confirmed", false).Error; err != nil {
+			return err
+		}
+		return dbtx.Model(tx).Update("confirmed", false).Error
+	})
+}
+
 func preloadAttempts(dbtx *gorm.DB) *gorm.DB {
 	return dbtx.
 		Preload("Attempts", func(db *gorm.DB) *gorm.DB {
@@ -696,6 +809,15 @@ func (orm *ORM) FindTx(ID uint64) (*models.Tx, error) {
 	return tx, err
 }
 
+// FindTxBySurrogateID returns the transaction created with the passed
+// surrogate ID.
+func (orm *ORM) FindTxBySurrogateID(surrogateID string) (*models.Tx, error) {
+	orm.MustEnsureAdvisoryLock()
+	tx := &models.Tx{}
+	err := preloadAttempts(orm.db).First(tx, "surrogate_id = ?", surrogateID).Error
+	return tx, err
+}
+
 // FindTxByAttempt returns the specific transaction attempt with the hash.
 func (orm *ORM) FindTxByAttempt(hash common.Hash) (*models.Tx, *models.TxAttempt, error) {
 	orm.MustEnsureAdvisoryLock()
@@ -749,6 +871,15 @@ func (orm *ORM) MarkRan(i *models.Initiator, ran bool) error {
 	})
 }
 
+// SetLastProcessedBlock records the block of the last log processed by a log
+// initiator.
+func (orm *ORM) SetLastProcessedBlock(i *models.Initiator, blockNumber *big.Int) error {
+	orm.MustEnsureAdvisoryLock()
+	return orm.db.Model(&models.Initiator{}).
+		Where("id = ?", i.ID).
+		UpdateColumn("last_processed_block", utils.NewBig(blockNumber)).Error
+}
+
 // FindUser will return the one API user, or an error.
 func (orm *ORM) FindUser() (models.User, error) {
 	orm.MustEnsureAdvisoryLock()
@@ -926,6 +1057,18 @@ func (orm *ORM) TxAttempts(offset, limit int) ([]models.TxAttempt, int, error) {
 	return attempts, count, err
 }
 
+// ConfirmedTxsSentAfter returns all confirmed transactions, with their
+// attempts, that were sent after the passed block height.
+func (orm *ORM) ConfirmedTxsSentAfter(blockHeight uint64) ([]models.Tx, error) {
+	orm.MustEnsureAdvisoryLock()
+	var txs []models.Tx
+	err := preloadAttempts(orm.db).
+		Where("confirmed = ? AND sent_at > ?", true, blockHeight).
+		Order("id asc").
+		Find(&txs).Error
+	return txs, err
+}
+
 // UnconfirmedTxAttempts returns all TxAttempts for which the associated Tx is still unconfirmed.
 func (orm *ORM) UnconfirmedTxAttempts() ([]models.TxAttempt, error) {
 	orm.MustEnsureAdvisoryLock()
@@ -1056,6 +1199,28 @@ func (orm *ORM) LastHead() (*models.Head, error) {
 	return number, err
 }
 
+// LastHeads returns the most recently persisted head entries, up to limit,
+// ordered from oldest to newest.
+func (orm *ORM) LastHeads(limit int) ([]models.Head, error) {
+	orm.MustEnsureAdvisoryLock()
+	var heads []models.Head
+	err := orm.db.Order("number desc, id desc").Limit(limit).Find(&heads).Error
+	if err != nil {
+		return nil, err
+	}
+	for i, j := 0, len(heads)-1; i < j; i, j = i+1, j-1 {
+		heads[i], heads[j] = heads[j], heads[i]
+	}
+	return heads, nil
+}
+
+// DeleteHeadsAfter removes all persisted heads with a block number greater
+// than the one passed, used to discard blocks orphaned by a reorganization.
+func (orm *ORM) DeleteHeadsAfter(number int64) error {
+	orm.MustEnsureAdvisoryLock()
+	return orm.db.Where("number > ?", number).Delete(models.Head{}).Error
+}
+
 // DeleteStaleSessions deletes all sessions before the passed time.
 func (orm *ORM) DeleteStaleSessions(before time.Time) error {
 	orm.MustEnsureAdvisoryLock()
@@ -1147,6 +1312,12 @@ func (orm *ORM) FirstOrCreateKey(k *models.Key) error {
 	return orm.db.FirstOrCreate(k).Error
 }
 
+// DeleteKey removes the key with the given address from the orm.
+func (orm *ORM) DeleteKey(address models.EIP55Address) error {
+	orm.MustEnsureAdvisoryLock()
+	return orm.db.Delete(&models.Key{Address: address}).Error
+}
+
 // ClobberDiskKeyStoreWithDBKeys writes all keys stored in the orm to
 // the keys folder on disk, deleting anything there prior.
 func (orm *ORM) ClobberDiskKeyStoreWithDBKeys(keysDir string) error {
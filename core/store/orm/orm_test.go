@@ -162,6 +162,57 @@ func TestORM_CreateJobRun_CreatesRunRequest(t *testing.T) {
 	assert.Equal(t, 1, requestCount)
 }
 
+func TestORM_FindJobRunForLog(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithLogInitiator()
+	require.NoError(t, store.CreateJob(&job))
+	initr := job.Initiators[0]
+
+	blockHash, txHash := cltest.NewHash(), cltest.NewHash()
+	logIndex := uint(2)
+	rr := models.NewRunRequest()
+	rr.BlockHash = &blockHash
+	rr.TxHash = &txHash
+	rr.InitiatorID = &initr.ID
+	rr.LogIndex = &logIndex
+	data := cltest.JSONFromString(t, `{"random": "input"}`)
+	run, _ := services.NewRun(&job, &initr, &data, big.NewInt(0), rr, store.Config, store.ORM, time.Now())
+	require.NoError(t, store.CreateJobRun(run))
+
+	found, err := store.FindJobRunForLog(initr.ID, blockHash, txHash, logIndex)
+	require.NoError(t, err)
+	assert.Equal(t, run.ID, found.ID)
+
+	_, err = store.FindJobRunForLog(initr.ID, blockHash, txHash, logIndex+1)
+	assert.Equal(t, orm.ErrorNotFound, err)
+	_, err = store.FindJobRunForLog(initr.ID, cltest.NewHash(), txHash, logIndex)
+	assert.Equal(t, orm.ErrorNotFound, err, "should not find the run for the log mined in another block")
+
+	duplicate := *rr
+	duplicate.ID = 0
+	run, _ = services.NewRun(&job, &initr, &data, big.NewInt(0), &duplicate, store.Config, store.ORM, time.Now())
+	assert.Error(t, store.CreateJobRun(run), "should not create a second run request for the same log")
+}
+
+func TestORM_SetLastProcessedBlock(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithLogInitiator()
+	require.NoError(t, store.CreateJob(&job))
+	initr := job.Initiators[0]
+
+	require.NoError(t, store.SetLastProcessedBlock(&initr, big.NewInt(42)))
+
+	ir, err := store.FindInitiator(initr.ID)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(42), ir.LastProcessedBlock.ToInt())
+}
+
 func TestORM_SaveJobRun_ArchivedDoesNotRevertDeletedAt(t *testing.T) {
 	t.Parallel()
 	store, cleanup := cltest.NewStore(t)
@@ -15,6 +15,8 @@ import (
 // ConfigSchema records the schema of configuration at the type level
 type ConfigSchema struct {
 	AllowOrigins              string         `env:"ALLOW_ORIGINS" default:"http://localhost:3000,http://localhost:6688"`
+	BalanceMonitorEnabled     bool           `env:"BALANCE_MONITOR_ENABLED" default:"true"`
+	BalanceWebhookURL         *url.URL       `env:"BALANCE_WEBHOOK_URL"`
 	BridgeResponseURL         url.URL        `env:"BRIDGE_RESPONSE_URL"`
 	ChainID                   big.Int        `env:"ETH_CHAIN_ID" default:"0"`
 	ClientNodeURL             string         `env:"CLIENT_NODE_URL" default:"http://localhost:6688"`
@@ -25,15 +27,33 @@ type ConfigSchema struct {
 	FeatureExternalInitiators bool           `env:"FEATURE_EXTERNAL_INITIATORS" default:"false"`
 	MaximumServiceDuration    time.Duration  `env:"MAXIMUM_SERVICE_DURATION" default:"8760h" `
 	MinimumServiceDuration    time.Duration  `env:"MINIMUM_SERVICE_DURATION" default:"0s" `
+	EthBalanceThreshold       big.Int        `env:"ETH_BALANCE_THRESHOLD" default:"100000000000000000"`
+	EthBroadcastTxs           bool           `env:"ETH_BROADCAST_TXS" default:"false"`
 	EthGasBumpThreshold       uint64         `env:"ETH_GAS_BUMP_THRESHOLD" default:"12" `
+	EthGasBumpPercent         uint64         `env:"ETH_GAS_BUMP_PERCENT" default:"10"`
 	EthGasBumpWei             big.Int        `env:"ETH_GAS_BUMP_WEI" default:"5000000000"`
+	EthGasEstimateMultiplier  float64        `env:"ETH_GAS_ESTIMATE_MULTIPLIER" default:"1.25"`
+	EthGasLimitMax            uint64         `env:"ETH_GAS_LIMIT_MAX" default:"2000000"`
+	EthGasLimitMin            uint64         `env:"ETH_GAS_LIMIT_MIN" default:"21000"`
 	EthGasPriceDefault        big.Int        `env:"ETH_GAS_PRICE_DEFAULT" default:"20000000000"`
+	EthGasPriceBlockHistory   uint64         `env:"ETH_GAS_PRICE_BLOCK_HISTORY" default:"20"`
+	EthGasPricePercentile     uint64         `env:"ETH_GAS_PRICE_PERCENTILE" default:"60"`
+	EthHealthCheckInterval    time.Duration  `env:"ETH_HEALTH_CHECK_INTERVAL" default:"15s"`
+	EthHTTPURL                *url.URL       `env:"ETH_HTTP_URL"`
+	EthMaxErrorRate           float64        `env:"ETH_MAX_ERROR_RATE" default:"0.5"`
+	EthMaxGasPriceWei         big.Int        `env:"ETH_MAX_GAS_PRICE_WEI" default:"1500000000000"`
+	EthMaxHeadLag             uint64         `env:"ETH_MAX_HEAD_LAG" default:"10"`
+	EthMinGasPriceWei         big.Int        `env:"ETH_MIN_GAS_PRICE_WEI" default:"1000000000"`
+	EthPollingBlockRange      uint64         `env:"ETH_POLLING_BLOCK_RANGE" default:"1000"`
+	EthPollingInterval        time.Duration  `env:"ETH_POLLING_INTERVAL" default:"5s"`
 	EthereumURL               string         `env:"ETH_URL" default:"ws://localhost:8546"`
 	JSONConsole               bool           `env:"JSON_CONSOLE" default:"false"`
 	LinkContractAddress       string         `env:"LINK_CONTRACT_ADDRESS" default:"0x514910771AF9Ca656af840dff83E8264EcF986CA"`
 	ExplorerURL               *url.URL       `env:"EXPLORER_URL"`
 	ExplorerAccessKey         string         `env:"EXPLORER_ACCESS_KEY"`
 	ExplorerSecret            string         `env:"EXPLORER_SECRET"`
+	LogBackfillBlockWindow    uint64         `env:"LOG_BACKFILL_BLOCK_WINDOW" default:"1000"`
+	LogBackfillMaxRetries     uint64         `env:"LOG_BACKFILL_MAX_RETRIES" default:"5"`
 	LogLevel                  LogLevel       `env:"LOG_LEVEL" default:"info"`
 	LogToDisk                 bool           `env:"LOG_TO_DISK" default:"true"`
 	LogSQLStatements          bool           `env:"LOG_SQL" default:"false"`
@@ -48,6 +68,9 @@ type ConfigSchema struct {
 	ReaperExpiration          time.Duration  `env:"REAPER_EXPIRATION" default:"240h"`
 	ReplayFromBlock           int64          `env:"REPLAY_FROM_BLOCK" default:"-1"`
 	RootDir                   string         `env:"ROOT" default:"~/.chainlink"`
+	RunQueueMaxPending        uint64         `env:"RUN_QUEUE_MAX_PENDING" default:"10000"`
+	RunQueueMaxWorkers        uint64         `env:"RUN_QUEUE_MAX_WORKERS" default:"100"`
+	RunQueueMaxWorkersPerJob  uint64         `env:"RUN_QUEUE_MAX_WORKERS_PER_JOB" default:"0"`
 	SecureCookies             bool           `env:"SECURE_COOKIES" default:"true"`
 	SessionTimeout            time.Duration  `env:"SESSION_TIMEOUT" default:"15m"`
 	TLSCertPath               string         `env:"TLS_CERT_PATH" `
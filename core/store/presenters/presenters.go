@@ -25,6 +25,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
 	"github.com/tidwall/gjson"
 	"go.uber.org/multierr"
 )
@@ -114,6 +115,32 @@ func (a *AccountBalance) SetID(value string) error {
 	return nil
 }
 
+// Key holds the hex representation of the address of one of the node's keys,
+// its ETH & LINK balances and the nonce of the next transaction sent from it.
+// NextNonce is nil when the key is not active for sending transactions.
+type Key struct {
+	Address     string       `json:"address"`
+	EthBalance  *assets.Eth  `json:"ethBalance"`
+	LinkBalance *assets.Link `json:"linkBalance"`
+	NextNonce   *uint64      `json:"nextNonce"`
+}
+
+// GetID returns the ID of this structure for jsonapi serialization.
+func (k Key) GetID() string {
+	return k.Address
+}
+
+// GetName returns the collection name for jsonapi.
+func (k Key) GetName() string {
+	return "keys"
+}
+
+// SetID is used to set the ID of this structure when deserializing from jsonapi documents.
+func (k *Key) SetID(value string) error {
+	k.Address = value
+	return nil
+}
+
 // ConfigWhitelist are the non-secret values of the node
 //
 // If you add an entry here, you should update NewConfigWhitelist and
@@ -126,18 +153,37 @@ type ConfigWhitelist struct {
 // Whitelist contains the supported environment variables
 type Whitelist struct {
 	AllowOrigins             string          `json:"allowOrigins"`
+	BalanceMonitorEnabled    bool            `json:"balanceMonitorEnabled"`
 	BridgeResponseURL        string          `json:"bridgeResponseURL,omitempty"`
 	ChainID                  *big.Int        `json:"ethChainId"`
 	ClientNodeURL            string          `json:"clientNodeUrl"`
 	DatabaseTimeout          time.Duration   `json:"databaseTimeout"`
 	Dev                      bool            `json:"chainlinkDev"`
 	EthereumURL              string          `json:"ethUrl"`
+	EthBalanceThreshold      *assets.Eth     `json:"ethBalanceThreshold"`
+	EthBroadcastTxs          bool            `json:"ethBroadcastTxs"`
 	EthGasBumpThreshold      uint64          `json:"ethGasBumpThreshold"`
+	EthGasBumpPercent        uint64          `json:"ethGasBumpPercent"`
 	EthGasBumpWei            *big.Int        `json:"ethGasBumpWei"`
+	EthGasEstimateMultiplier float64         `json:"ethGasEstimateMultiplier"`
+	EthGasLimitMax           uint64          `json:"ethGasLimitMax"`
+	EthGasLimitMin           uint64          `json:"ethGasLimitMin"`
 	EthGasPriceDefault       *big.Int        `json:"ethGasPriceDefault"`
+	EthGasPriceBlockHistory  uint64          `json:"ethGasPriceBlockHistory"`
+	EthGasPricePercentile    uint64          `json:"ethGasPricePercentile"`
+	EthHealthCheckInterval   time.Duration   `json:"ethHealthCheckInterval"`
+	EthHTTPURL               string          `json:"ethHttpUrl"`
+	EthMaxErrorRate          float64         `json:"ethMaxErrorRate"`
+	EthMaxGasPriceWei        *big.Int        `json:"ethMaxGasPriceWei"`
+	EthMaxHeadLag            uint64          `json:"ethMaxHeadLag"`
+	EthMinGasPriceWei        *big.Int        `json:"ethMinGasPriceWei"`
+	EthPollingBlockRange     uint64          `json:"ethPollingBlockRange"`
+	EthPollingInterval       time.Duration   `json:"ethPollingInterval"`
 	ExplorerURL              string          `json:"explorerUrl"`
 	JSONConsole              bool            `json:"jsonConsole"`
 	LinkContractAddress      string          `json:"linkContractAddress"`
+	LogBackfillBlockWindow   uint64          `json:"logBackfillBlockWindow"`
+	LogBackfillMaxRetries    uint64          `json:"logBackfillMaxRetries"`
 	LogLevel                 orm.LogLevel    `json:"logLevel"`
 	LogSQLMigrations         bool            `json:"logSqlMigrations"`
 	LogSQLStatements         bool            `json:"logSqlStatements"`
@@ -152,6 +198,9 @@ type Whitelist struct {
 	ReaperExpiration         time.Duration   `json:"reaperExpiration"`
 	ReplayFromBlock          int64           `json:"replayFromBlock"`
 	RootDir                  string          `json:"root"`
+	RunQueueMaxPending       uint64          `json:"runQueueMaxPending"`
+	RunQueueMaxWorkers       uint64          `json:"runQueueMaxWorkers"`
+	RunQueueMaxWorkersPerJob uint64          `json:"runQueueMaxWorkersPerJob"`
 	SessionTimeout           time.Duration   `json:"sessionTimeout"`
 	TLSHost                  string          `json:"chainlinkTLSHost"`
 	TLSPort                  uint16          `json:"chainlinkTLSPort"`
@@ -171,22 +220,45 @@ func NewConfigWhitelist(store *store.Store) (ConfigWhitelist, error) {
 	if config.ExplorerURL() != nil {
 		explorerURL = config.ExplorerURL().String()
 	}
+	ethHTTPURL := ""
+	if config.EthHTTPURL() != nil {
+		ethHTTPURL = config.EthHTTPURL().String()
+	}
 	return ConfigWhitelist{
 		AccountAddress: account.Address.Hex(),
 		Whitelist: Whitelist{
 			AllowOrigins:             config.AllowOrigins(),
+			BalanceMonitorEnabled:    config.BalanceMonitorEnabled(),
 			BridgeResponseURL:        config.BridgeResponseURL().String(),
 			ChainID:                  config.ChainID(),
 			ClientNodeURL:            config.ClientNodeURL(),
 			Dev:                      config.Dev(),
 			DatabaseTimeout:          config.DatabaseTimeout(),
 			EthereumURL:              config.EthereumURL(),
+			EthBalanceThreshold:      config.EthBalanceThreshold(),
+			EthBroadcastTxs:          config.EthBroadcastTxs(),
 			EthGasBumpThreshold:      config.EthGasBumpThreshold(),
+			EthGasBumpPercent:        config.EthGasBumpPercent(),
 			EthGasBumpWei:            config.EthGasBumpWei(),
+			EthGasEstimateMultiplier: config.EthGasEstimateMultiplier(),
+			EthGasLimitMax:           config.EthGasLimitMax(),
+			EthGasLimitMin:           config.EthGasLimitMin(),
 			EthGasPriceDefault:       config.EthGasPriceDefault(),
+			EthGasPriceBlockHistory:  config.EthGasPriceBlockHistory(),
+			EthGasPricePercentile:    config.EthGasPricePercentile(),
+			EthHealthCheckInterval:   config.EthHealthCheckInterval(),
+			EthHTTPURL:               ethHTTPURL,
+			EthMaxErrorRate:          config.EthMaxErrorRate(),
+			EthMaxGasPriceWei:        config.EthMaxGasPriceWei(),
+			EthMaxHeadLag:            config.EthMaxHeadLag(),
+			EthMinGasPriceWei:        config.EthMinGasPriceWei(),
+			EthPollingBlockRange:     config.EthPollingBlockRange(),
+			EthPollingInterval:       config.EthPollingInterval(),
 			JSONConsole:              config.JSONConsole(),
 			LinkContractAddress:      config.LinkContractAddress(),
 			ExplorerURL:              explorerURL,
+			LogBackfillBlockWindow:   config.LogBackfillBlockWindow(),
+			LogBackfillMaxRetries:    config.LogBackfillMaxRetries(),
 			LogLevel:                 config.LogLevel(),
 			LogToDisk:                config.LogToDisk(),
 			LogSQLStatements:         config.LogSQLStatements(),
@@ -201,6 +273,9 @@ func NewConfigWhitelist(store *store.Store) (ConfigWhitelist, error) {
 			ReaperExpiration:         config.ReaperExpiration(),
 			ReplayFromBlock:          config.ReplayFromBlock(),
 			RootDir:                  config.RootDir(),
+			RunQueueMaxPending:       config.RunQueueMaxPending(),
+			RunQueueMaxWorkers:       config.RunQueueMaxWorkers(),
+			RunQueueMaxWorkersPerJob: config.RunQueueMaxWorkersPerJob(),
 			SessionTimeout:           config.SessionTimeout(),
 			TLSHost:                  config.TLSHost(),
 			TLSPort:                  config.TLSPort(),
@@ -379,12 +454,35 @@ func initiatorParams(i Initiator) (interface{}, error) {
 		}{i.Name}, nil
 	case models.InitiatorFluxMonitor:
 		return struct {
-			Address     common.Address `json:"address"`
-			RequestData models.JSON    `json:"requestData"`
-			Feeds       models.Feeds   `json:"feeds"`
-			Threshold   float32        `json:"threshold"`
-			Precision   int32          `json:"precision"`
-		}{i.Address, i.RequestData, i.Feeds, i.Threshold, i.Precision}, nil
+			Address          common.Address  `json:"address"`
+			RequestData      models.JSON     `json:"requestData"`
+			Feeds            models.Feeds    `json:"feeds"`
+			Threshold        float32         `json:"threshold"`
+			Precision        int32           `json:"precision"`
+			MinimumResponses uint32          `json:"minimumResponses,omitempty"`
+			PollInterval     models.Duration `json:"pollInterval,omitempty"`
+			HTTPTimeout      models.Duration `json:"httpTimeout,omitempty"`
+			IdleThreshold    models.Duration `json:"idleThreshold,omitempty"`
+
+			AbsoluteThreshold *decimal.Decimal     `json:"absoluteThreshold,omitempty"`
+			ThresholdMode     models.ThresholdMode `json:"thresholdMode,omitempty"`
+			MinAnswer         *decimal.Decimal     `json:"minAnswer,omitempty"`
+			MaxAnswer         *decimal.Decimal     `json:"maxAnswer,omitempty"`
+		}{
+			i.Address,
+			i.RequestData,
+			i.Feeds,
+			i.Threshold,
+			i.Precision,
+			i.MinimumResponses,
+			i.PollInterval,
+			i.HTTPTimeout,
+			i.IdleThreshold,
+			i.AbsoluteThreshold,
+			i.ThresholdMode,
+			i.MinAnswer,
+			i.MaxAnswer,
+		}, nil
 	default:
 		return nil, fmt.Errorf("Cannot marshal unsupported initiator type '%v'", i.Type)
 	}
@@ -561,32 +659,38 @@ func (a NewAccount) GetName() string {
 // Tx is a jsonapi wrapper for an Ethereum Transaction.
 type Tx struct {
 	Confirmed bool            `json:"confirmed,omitempty"`
-	Data      hexutil.Bytes   `json:"data,omitempty"`
-	From      *common.Address `json:"from,omitempty"`
-	GasLimit  string          `json:"gasLimit,omitempty"`
-	GasPrice  string          `json:"gasPrice,omitempty"`
-	Hash      common.Hash     `json:"hash,omitempty"`
-	Hex       string          `json:"rawHex,omitempty"`
-	Nonce     string          `json:"nonce,omitempty"`
-	SentAt    string          `json:"sentAt,omitempty"`
-	To        *common.Address `json:"to,omitempty"`
-	Value     string          `json:"value,omitempty"`
+	Data        hexutil.Bytes   `json:"data,omitempty"`
+	From        *common.Address `json:"from,omitempty"`
+	GasEstimate string          `json:"gasEstimate,omitempty"`
+	GasLimit    string          `json:"gasLimit,omitempty"`
+	GasPrice    string          `json:"gasPrice,omitempty"`
+	Hash        common.Hash     `json:"hash,omitempty"`
+	Hex         string          `json:"rawHex,omitempty"`
+	Nonce       string          `json:"nonce,omitempty"`
+	SentAt      string          `json:"sentAt,omitempty"`
+	To          *common.Address `json:"to,omitempty"`
+	Value       string          `json:"value,omitempty"`
 }
 
 // NewTx builds a transaction presenter.
 func NewTx(tx *models.Tx) Tx {
+	var gasEstimate string
+	if tx.GasEstimate > 0 {
+		gasEstimate = strconv.FormatUint(tx.GasEstimate, 10)
+	}
 	return Tx{
-		Confirmed: tx.Confirmed,
-		Data:      hexutil.Bytes(tx.Data),
-		From:      &tx.From,
-		GasLimit:  strconv.FormatUint(tx.GasLimit, 10),
-		GasPrice:  tx.GasPrice.String(),
-		Hash:      tx.Hash,
-		Hex:       tx.SignedRawTx,
-		Nonce:     strconv.FormatUint(tx.Nonce, 10),
-		SentAt:    strconv.FormatUint(tx.SentAt, 10),
-		To:        &tx.To,
-		Value:     tx.Value.String(),
+		Confirmed:   tx.Confirmed,
+		Data:        hexutil.Bytes(tx.Data),
+		From:        &tx.From,
+		GasEstimate: gasEstimate,
+		GasLimit:    strconv.FormatUint(tx.GasLimit, 10),
+		GasPrice:    tx.GasPrice.String(),
+		Hash:        tx.Hash,
+		Hex:         tx.SignedRawTx,
+		Nonce:       strconv.FormatUint(tx.Nonce, 10),
+		SentAt:      strconv.FormatUint(tx.SentAt, 10),
+		To:          &tx.To,
+		Value:       tx.Value.String(),
 	}
 }
 
@@ -674,6 +778,76 @@ func (ei *ExternalInitiatorAuthentication) SetID(name string) error {
 	return nil
 }
 
+// FluxMonitorStatus is a jsonapi wrapper for the status of one of a job's flux
+// monitor initiators.
+type FluxMonitorStatus struct {
+	models.FluxMonitorStatus
+}
+
+// GetID returns the jsonapi ID.
+func (s FluxMonitorStatus) GetID() string {
+	return strconv.FormatUint(uint64(s.InitiatorID), 10)
+}
+
+// GetName returns the collection name for jsonapi.
+func (FluxMonitorStatus) GetName() string {
+	return "flux_monitor_statuses"
+}
+
+// SetID is used to conform to the UnmarshallIdentifier interface for
+// deserializing from jsonapi documents.
+func (s *FluxMonitorStatus) SetID(value string) error {
+	id, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		return err
+	}
+	s.InitiatorID = uint(id)
+	return nil
+}
+
+// FriendlyCurrentRound returns the on-chain round as a string.
+func (s FluxMonitorStatus) FriendlyCurrentRound() string {
+	if s.CurrentRound == nil {
+		return ""
+	}
+	return s.CurrentRound.String()
+}
+
+// FriendlyLastFetchedPrice returns the last median of the feeds as a string.
+func (s FluxMonitorStatus) FriendlyLastFetchedPrice() string {
+	if s.LastFetchedPrice == nil {
+		return ""
+	}
+	return s.LastFetchedPrice.String()
+}
+
+// FriendlyLastPolledAt returns a human-readable string of when the feeds were
+// last polled.
+func (s FluxMonitorStatus) FriendlyLastPolledAt() string {
+	return friendlyTimePtr(s.LastPolledAt)
+}
+
+// FriendlyLastSubmittedAt returns a human-readable string of when an answer
+// was last submitted.
+func (s FluxMonitorStatus) FriendlyLastSubmittedAt() string {
+	return friendlyTimePtr(s.LastSubmittedAt)
+}
+
+// FriendlyLastError returns the last error along with when it happened.
+func (s FluxMonitorStatus) FriendlyLastError() string {
+	if s.LastError == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s (%s)", s.LastError, friendlyTimePtr(s.LastErrorAt))
+}
+
+func friendlyTimePtr(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return utils.ISO8601UTC(*t)
+}
+
 // ExplorerStatus represents the connected server and status of the connection
 type ExplorerStatus struct {
 	Status string `json:"status"`
@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
@@ -34,6 +35,7 @@ type Store struct {
 	KeyStore    *KeyStore
 	TxManager   TxManager
 	StatsPusher *synchronization.StatsPusher
+	ethNodePool *ethNodePool
 	closeOnce   sync.Once
 }
 
@@ -45,7 +47,7 @@ type lazyRPCWrapper struct {
 	limiter     *rate.Limiter
 }
 
-func newLazyRPCWrapper(urlString string, limiter *rate.Limiter) (eth.CallerSubscriber, error) {
+func newLazyRPCWrapper(urlString string, limiter *rate.Limiter) (*lazyRPCWrapper, error) {
 	parsed, err := url.ParseRequestURI(urlString)
 	if err != nil {
 		return nil, err
@@ -53,12 +55,44 @@ func newLazyRPCWrapper(urlString string, limiter *rate.Limiter) (eth.CallerSubsc
 	if parsed.Scheme != "ws" && parsed.Scheme != "wss" {
 		return nil, fmt.Errorf("Ethereum url scheme must be websocket: %s", parsed.String())
 	}
+	return newLazyRPCWrapperForURL(parsed, limiter), nil
+}
+
+func newLazyHTTPRPCWrapper(urlString string, limiter *rate.Limiter) (*lazyRPCWrapper, error) {
+	parsed, err := url.ParseRequestURI(urlString)
+	if err != nil {
+		return nil, err
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return nil, fmt.Errorf("Ethereum http url scheme must be http or https: %s", parsed.String())
+	}
+	return newLazyRPCWrapperForURL(parsed, limiter), nil
+}
+
+// newLazyRPCWrapperForScheme returns a lazyRPCWrapper for a websocket or http
+// url. Http urls cannot make subscriptions, so the node polls for logs and
+// heads through them instead, see isPollingURL.
+func newLazyRPCWrapperForScheme(urlString string, limiter *rate.Limiter) (*lazyRPCWrapper, error) {
+	if isPollingURL(urlString) {
+		return newLazyHTTPRPCWrapper(urlString, limiter)
+	}
+	return newLazyRPCWrapper(urlString, limiter)
+}
+
+// isPollingURL returns true if the url is an http url, through which the node
+// polls for logs and heads rather than subscribing to them.
+func isPollingURL(urlString string) bool {
+	parsed, err := url.ParseRequestURI(strings.TrimSpace(urlString))
+	return err == nil && (parsed.Scheme == "http" || parsed.Scheme == "https")
+}
+
+func newLazyRPCWrapperForURL(parsed *url.URL, limiter *rate.Limiter) *lazyRPCWrapper {
 	return &lazyRPCWrapper{
 		url:         parsed,
 		mutex:       &sync.Mutex{},
 		initialized: abool.New(),
 		limiter:     limiter,
-	}, nil
+	}
 }
 
 // lazyDialInitializer initializes the Dial instance used to interact with
@@ -96,6 +130,36 @@ func (wrapper *lazyRPCWrapper) Call(result interface{}, method string, args ...i
 	return wrapper.client.Call(result, method, args...)
 }
 
+// CallContext makes a call that is abandoned once the context is done, so
+// that a node that does not respond cannot hold up the caller.
+func (wrapper *lazyRPCWrapper) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	err := wrapper.lazyDialInitializer()
+	if err != nil {
+		return err
+	}
+
+	if err := wrapper.limiter.Wait(ctx); err != nil {
+		return err
+	}
+
+	return wrapper.client.CallContext(ctx, result, method, args...)
+}
+
+// BatchCall sends the calls in a single batch request, which counts once
+// towards MAX_RPC_CALLS_PER_SECOND.
+func (wrapper *lazyRPCWrapper) BatchCall(b []rpc.BatchElem) error {
+	err := wrapper.lazyDialInitializer()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	wrapper.limiter.Wait(ctx)
+
+	return wrapper.client.BatchCall(b)
+}
+
 func (wrapper *lazyRPCWrapper) Subscribe(ctx context.Context, channel interface{}, args ...interface{}) (eth.Subscription, error) {
 	err := wrapper.lazyDialInitializer()
 	if err != nil {
@@ -104,33 +168,73 @@ func (wrapper *lazyRPCWrapper) Subscribe(ctx context.Context, channel interface{
 	return wrapper.client.EthSubscribe(ctx, channel, args...)
 }
 
-// Dialer implements Dial which is a function that creates a client for that url
+// splitCallerSubscriber sends calls to one ethereum node, usually over http,
+// and subscriptions to another, usually over websocket.
+type splitCallerSubscriber struct {
+	caller     eth.CallerSubscriber
+	subscriber eth.CallerSubscriber
+}
+
+func (split *splitCallerSubscriber) Call(result interface{}, method string, args ...interface{}) error {
+	return split.caller.Call(result, method, args...)
+}
+
+func (split *splitCallerSubscriber) BatchCall(b []rpc.BatchElem) error {
+	client := eth.CallerSubscriberClient{CallerSubscriber: split.caller}
+	return client.BatchCall(b)
+}
+
+func (split *splitCallerSubscriber) Subscribe(ctx context.Context, channel interface{}, args ...interface{}) (eth.Subscription, error) {
+	return split.subscriber.Subscribe(ctx, channel, args...)
+}
+
+// Dialer implements Dial which is a function that creates a client for that
+// url, and DialHTTP which creates a client for calls over http to that url
 type Dialer interface {
 	Dial(string) (eth.CallerSubscriber, error)
+	DialHTTP(string) (eth.CallerSubscriber, error)
 }
 
 // EthDialer is Dialer which accesses rpc urls
 type EthDialer struct {
 	limiter *rate.Limiter
+	config  orm.ConfigReader
 }
 
-// NewEthDialer returns an eth dialer with the specified rate limit
-func NewEthDialer(rateLimit uint64) *EthDialer {
+// NewEthDialer returns an eth dialer with the rate limit and node health
+// checks of the given config
+func NewEthDialer(config orm.ConfigReader) *EthDialer {
 	return &EthDialer{
-		limiter: rate.NewLimiter(rate.Limit(rateLimit), 1),
+		limiter: rate.NewLimiter(rate.Limit(config.MaxRPCCallsPerSecond()), 1),
+		config:  config,
 	}
 }
 
-// Dial will dial the given url and return a CallerSubscriber
+// Dial will dial the given websocket or http url and return a
+// CallerSubscriber. A comma separated list of urls is dialed as a pool of
+// nodes, failing over from the first to the others when it is unhealthy.
 func (ed *EthDialer) Dial(urlString string) (eth.CallerSubscriber, error) {
-	return newLazyRPCWrapper(urlString, ed.limiter)
+	urls := strings.Split(urlString, ",")
+	if len(urls) == 1 {
+		return newLazyRPCWrapperForScheme(urlString, ed.limiter)
+	}
+	for i := range urls {
+		urls[i] = strings.TrimSpace(urls[i])
+	}
+	return newEthNodePool(urls, ed.limiter, ed.config)
+}
+
+// DialHTTP will return a CallerSubscriber for calls to the given http url,
+// which cannot make subscriptions.
+func (ed *EthDialer) DialHTTP(urlString string) (eth.CallerSubscriber, error) {
+	return newLazyHTTPRPCWrapper(urlString, ed.limiter)
 }
 
 // NewStore will create a new database file at the config's RootDir if
 // it is not already present, otherwise it will use the existing db.sqlite3
 // file.
 func NewStore(config *orm.Config) *Store {
-	return NewStoreWithDialer(config, NewEthDialer(config.MaxRPCCallsPerSecond()))
+	return NewStoreWithDialer(config, NewEthDialer(config))
 }
 
 // NewStoreWithDialer creates a new store with the given config and dialer
@@ -143,7 +247,7 @@ func NewStoreWithDialer(config *orm.Config, dialer Dialer) *Store {
 // dialer, using an insecure keystore.
 // NOTE: Should only be used for testing!
 func NewInsecureStore(config *orm.Config) *Store {
-	dialer := NewEthDialer(config.MaxRPCCallsPerSecond())
+	dialer := NewEthDialer(config)
 	keyStore := func() *KeyStore { return NewInsecureKeyStore(config.KeysDir()) }
 	return newStoreWithDialerAndKeyStore(config, dialer, keyStore)
 }
@@ -165,13 +269,26 @@ func newStoreWithDialerAndKeyStore(
 	if err != nil {
 		logger.Fatal(fmt.Sprintf("Unable to dial ETH RPC port: %+v", err))
 	}
+	callerSubscriber := ethrpc
+	if httpURL := config.EthHTTPURL(); httpURL != nil {
+		httprpc, err := dialer.DialHTTP(httpURL.String())
+		if err != nil {
+			logger.Fatal(fmt.Sprintf("Unable to dial ETH HTTP RPC port: %+v", err))
+		}
+		callerSubscriber = &splitCallerSubscriber{caller: httprpc, subscriber: ethrpc}
+	}
 	if err := orm.ClobberDiskKeyStoreWithDBKeys(config.KeysDir()); err != nil {
 		logger.Fatal(fmt.Sprintf("Unable to migrate key store to disk: %+v", err))
 	}
 
 	keyStore := keyStoreGenerator()
-	callerSubscriberClient := &eth.CallerSubscriberClient{CallerSubscriber: ethrpc}
-	txManager := NewEthTxManager(callerSubscriberClient, config, keyStore, orm)
+	callerSubscriberClient := &eth.CallerSubscriberClient{CallerSubscriber: callerSubscriber}
+	var ethClient eth.Client = callerSubscriberClient
+	if usesPolling(config.EthereumURLs()) {
+		logger.Infow("ETH_URL is an http url, polling for logs and new heads", "interval", config.EthPollingInterval())
+		ethClient = eth.NewPollingClient(callerSubscriberClient, config.EthPollingInterval(), config.EthPollingBlockRange(), uint64(config.MinIncomingConfirmations()))
+	}
+	txManager := NewEthTxManager(ethClient, config, keyStore, orm)
 	statsPusher := synchronization.NewStatsPusher(
 		orm, config.ExplorerURL(), config.ExplorerAccessKey(), config.ExplorerSecret(),
 	)
@@ -183,26 +300,47 @@ func newStoreWithDialerAndKeyStore(
 		TxManager:   txManager,
 		StatsPusher: statsPusher,
 	}
+	if pool, ok := ethrpc.(*ethNodePool); ok {
+		store.ethNodePool = pool
+	}
 	return store
 }
 
+// usesPolling returns true if any of the urls is an http url, through which
+// subscriptions cannot be made.
+func usesPolling(urls []string) bool {
+	for _, u := range urls {
+		if isPollingURL(u) {
+			return true
+		}
+	}
+	return false
+}
+
 // Start initiates all of Store's dependencies including the TxManager.
 func (s *Store) Start() error {
 	s.TxManager.Register(s.KeyStore.Accounts())
-	return multierr.Combine(
+	merr := multierr.Combine(
 		s.SyncDiskKeyStoreToDB(),
 		s.StatsPusher.Start(),
 	)
+	if s.ethNodePool != nil {
+		merr = multierr.Append(merr, s.ethNodePool.Start())
+	}
+	return merr
 }
 
 // Close shuts down all of the working parts of the store.
 func (s *Store) Close() error {
-	var err1, err2 error
+	var err1, err2, err3 error
 	s.closeOnce.Do(func() {
 		err1 = s.StatsPusher.Close()
 		err2 = s.ORM.Close()
+		if s.ethNodePool != nil {
+			err3 = s.ethNodePool.Close()
+		}
 	})
-	return multierr.Combine(err1, err2)
+	return multierr.Combine(err1, err2, err3)
 }
 
 // Unscoped returns a shallow copy of the store, with an unscoped ORM allowing
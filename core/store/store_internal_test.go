@@ -0,0 +1,84 @@
+package store
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+func TestLazyHTTPRPCWrapper_InvalidScheme(t *testing.T) {
+	t.Parallel()
+
+	_, err := newLazyHTTPRPCWrapper("ws://localhost:8546", rate.NewLimiter(rate.Inf, 1))
+	assert.Error(t, err)
+}
+
+func TestUsesPolling(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		urls []string
+		want bool
+	}{
+		{"websocket", []string{"ws://localhost:8546"}, false},
+		{"secure websocket", []string{"wss://localhost:8546"}, false},
+		{"http", []string{"http://localhost:8545"}, true},
+		{"https", []string{"https://mainnet.infura.io/v3/key"}, true},
+		{"pool with an http node", []string{"ws://localhost:8546", " http://localhost:8545"}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, usesPolling(test.urls))
+		})
+	}
+}
+
+func TestNewLazyRPCWrapperForScheme(t *testing.T) {
+	t.Parallel()
+
+	limiter := rate.NewLimiter(rate.Inf, 1)
+	for _, u := range []string{"ws://localhost:8546", "http://localhost:8545"} {
+		wrapper, err := newLazyRPCWrapperForScheme(u, limiter)
+		require.NoError(t, err)
+		assert.Equal(t, u, wrapper.url.String())
+	}
+
+	_, err := newLazyRPCWrapperForScheme("ftp://localhost:8545", limiter)
+	assert.Error(t, err)
+}
+
+func TestSplitCallerSubscriber_BatchCallOverHTTP(t *testing.T) {
+	t.Parallel()
+
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("eth", &fakeEthService{chainID: 3, head: 100}))
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+	defer server.Stop()
+
+	caller, err := newLazyHTTPRPCWrapper(ts.URL, rate.NewLimiter(rate.Inf, 1))
+	require.NoError(t, err)
+	split := &splitCallerSubscriber{caller: caller, subscriber: nil}
+
+	var head hexutil.Uint64
+	var chainID hexutil.Big
+	batch := []rpc.BatchElem{
+		{Method: "eth_blockNumber", Result: &head},
+		{Method: "eth_chainId", Result: &chainID},
+		{Method: "eth_unsupported"},
+	}
+	require.NoError(t, split.BatchCall(batch))
+
+	assert.NoError(t, batch[0].Error)
+	assert.Equal(t, hexutil.Uint64(100), head)
+	assert.NoError(t, batch[1].Error)
+	assert.Equal(t, int64(3), chainID.ToInt().Int64())
+	assert.Error(t, batch[2].Error)
+}
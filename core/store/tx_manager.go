@@ -20,6 +20,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/tevino/abool"
 	"go.uber.org/multierr"
 	"gopkg.in/guregu/null.v3"
@@ -34,6 +35,26 @@ const nonceReloadLimit int = 1
 // ErrPendingConnection is the error returned if TxManager is not connected.
 var ErrPendingConnection = errors.New("Cannot talk to chain, pending connection")
 
+// ErrTxConfirmed is the error returned when cancelling a transaction that has
+// already been confirmed.
+var ErrTxConfirmed = errors.New("Transaction has already been confirmed")
+
+// InsufficientEthError is the error returned when creating a transaction
+// whose maximum cost exceeds the last known ETH balance of its sender.
+type InsufficientEthError struct {
+	Address common.Address
+	Balance *assets.Eth
+	Cost    *assets.Eth
+}
+
+// Error returns the error message
+func (err InsufficientEthError) Error() string {
+	return fmt.Sprintf(
+		"account %s has %s ETH, which cannot afford the transaction costing up to %s ETH",
+		err.Address.Hex(), err.Balance, err.Cost,
+	)
+}
+
 //go:generate mockery -name TxManager -output ../internal/mocks/ -case=underscore
 
 // TxManager represents an interface for interacting with the blockchain
@@ -43,8 +64,9 @@ type TxManager interface {
 	Register(accounts []accounts.Account)
 
 	CreateTx(to common.Address, data []byte) (*models.Tx, error)
-	CreateTxWithGas(surrogateID null.String, to common.Address, data []byte, gasPriceWei *big.Int, gasLimit uint64) (*models.Tx, error)
+	CreateTxWithGas(surrogateID null.String, to common.Address, data []byte, gasPriceWei *big.Int, gasLimit uint64, fromAddresses []common.Address) (*models.Tx, error)
 	CreateTxWithEth(from, to common.Address, value *assets.Eth) (*models.Tx, error)
+	CancelTx(hash common.Hash) (*models.Tx, error)
 	CheckAttempt(txAttempt *models.TxAttempt, blockHeight uint64) (*eth.TxReceipt, AttemptState, error)
 
 	BumpGasUntilSafe(hash common.Hash) (*eth.TxReceipt, AttemptState, error)
@@ -53,6 +75,8 @@ type TxManager interface {
 	WithdrawLINK(wr models.WithdrawalRequest) (common.Hash, error)
 	GetLINKBalance(address common.Address) (*assets.Link, error)
 	NextActiveAccount() *ManagedAccount
+	NextAccount(fromAddresses []common.Address) (*ManagedAccount, error)
+	GetAvailableAccount(from common.Address) *ManagedAccount
 
 	eth.Client
 }
@@ -68,25 +92,35 @@ type EthTxManager struct {
 	availableAccounts   []*ManagedAccount
 	availableAccountIdx int
 	accountsMutex       *sync.Mutex
+	attemptsMutex       *sync.Mutex
 	connected           *abool.AtomicBool
 	currentHead         models.Head
+	gasPriceEstimator   *GasPriceEstimator
+	samplingGasPrices   *abool.AtomicBool
+	bumpingCancelledTxs *abool.AtomicBool
 }
 
 // NewEthTxManager constructs an EthTxManager using the passed variables and
 // initializing internal variables.
 func NewEthTxManager(client eth.Client, config orm.ConfigReader, keyStore *KeyStore, orm *orm.ORM) *EthTxManager {
 	return &EthTxManager{
-		Client:        client,
-		config:        config,
-		keyStore:      keyStore,
-		orm:           orm,
-		accountsMutex: &sync.Mutex{},
-		connected:     abool.New(),
+		Client:              client,
+		config:              config,
+		keyStore:            keyStore,
+		orm:                 orm,
+		accountsMutex:       &sync.Mutex{},
+		attemptsMutex:       &sync.Mutex{},
+		connected:           abool.New(),
+		gasPriceEstimator:   NewGasPriceEstimator(client, config),
+		samplingGasPrices:   abool.New(),
+		bumpingCancelledTxs: abool.New(),
 	}
 }
 
 // Register activates accounts for outgoing transactions and client side
-// nonce management.
+// nonce management. Once connected, accounts that are no longer registered
+// stop sending transactions at once, and newly registered ones are activated,
+// so that keys can be added and removed without a restart.
 func (txm *EthTxManager) Register(accts []accounts.Account) {
 	txm.accountsMutex.Lock()
 	defer txm.accountsMutex.Unlock()
@@ -94,6 +128,39 @@ func (txm *EthTxManager) Register(accts []accounts.Account) {
 	cp := make([]accounts.Account, len(accts))
 	copy(cp, accts)
 	txm.registeredAccounts = cp
+
+	if txm.Connected() {
+		txm.syncAvailableAccounts()
+	}
+}
+
+// syncAvailableAccounts keeps the available accounts that are still
+// registered, and activates registered accounts that are not yet available.
+// The accounts mutex must be held.
+func (txm *EthTxManager) syncAvailableAccounts() {
+	available := make(map[common.Address]*ManagedAccount)
+	for _, ma := range txm.availableAccounts {
+		available[ma.Address] = ma
+	}
+
+	txm.availableAccounts = []*ManagedAccount{}
+	for _, a := range txm.registeredAccounts {
+		ma, ok := available[a.Address]
+		if !ok {
+			var err error
+			if ma, err = txm.activateAccount(a); err != nil {
+				logger.Warnw("Unable to activate account", "address", a.Address.Hex(), "error", err)
+				continue
+			}
+		}
+		txm.availableAccounts = append(txm.availableAccounts, ma)
+	}
+
+	if len(txm.availableAccounts) > 0 {
+		txm.availableAccountIdx %= len(txm.availableAccounts)
+	} else {
+		txm.availableAccountIdx = 0
+	}
 }
 
 // Connected returns a bool indicating whether or not it is connected.
@@ -125,12 +192,18 @@ func (txm *EthTxManager) Connect(bn *models.Head) error {
 	}()
 
 	// Upon connecting/reconnecting, rebroadcast any transactions that are still unconfirmed
+	return multierr.Append(merr, txm.rebroadcastUnconfirmedTxs())
+}
+
+// rebroadcastUnconfirmedTxs resends the highest priced attempt of every
+// transaction that is still unconfirmed.
+func (txm *EthTxManager) rebroadcastUnconfirmedTxs() error {
 	attempts, err := txm.orm.UnconfirmedTxAttempts()
 	if err != nil {
-		merr = multierr.Append(merr, err)
-		return merr
+		return err
 	}
 
+	txm.warnOfStuckTxs(attempts)
 	attempts = models.HighestPricedTxAttemptPerTx(attempts)
 
 	for _, attempt := range attempts {
@@ -151,7 +224,49 @@ func (txm *EthTxManager) Connect(bn *models.Head) error {
 		}
 	}
 
-	return merr
+	return nil
+}
+
+// warnOfStuckTxs warns about the unconfirmed transactions that block every
+// later transaction from their account: one that is next to be mined but has
+// reached TxAttemptLimit, or one that follows a nonce never sent.
+func (txm *EthTxManager) warnOfStuckTxs(attempts []models.TxAttempt) {
+	attemptCounts := map[uint64]uint64{}
+	for _, attempt := range attempts {
+		attemptCounts[attempt.TxID]++
+	}
+
+	lowest := map[common.Address]models.TxAttempt{}
+	for _, attempt := range models.HighestPricedTxAttemptPerTx(attempts) {
+		ma := txm.getAccount(attempt.Tx.From)
+		if ma == nil || ma.Nonce() > attempt.Tx.Nonce {
+			continue
+		}
+		if current, ok := lowest[attempt.Tx.From]; !ok || attempt.Tx.Nonce < current.Tx.Nonce {
+			lowest[attempt.Tx.From] = attempt
+		}
+	}
+
+	for from, attempt := range lowest {
+		nonce := txm.getAccount(from).Nonce()
+		if attempt.Tx.Nonce > nonce {
+			logger.Warnw(
+				fmt.Sprintf("Nonce gap: unconfirmed transactions from %s start at nonce %d, but the next nonce to be mined is %d", from.Hex(), attempt.Tx.Nonce, nonce),
+				"from", from.Hex(),
+				"nonce", nonce,
+				"txHash", attempt.Hash.Hex(),
+				"txID", attempt.TxID,
+			)
+		} else if attemptCounts[attempt.TxID] > uint64(txm.config.TxAttemptLimit()) {
+			logger.Warnw(
+				fmt.Sprintf("Stuck transaction: Tx %s has met TxAttemptLimit, blocking later transactions from %s. Cancel it with `chainlink txs cancel %s`", attempt.Hash.Hex(), from.Hex(), attempt.Hash.Hex()),
+				"from", from.Hex(),
+				"nonce", nonce,
+				"txHash", attempt.Hash.Hex(),
+				"txID", attempt.TxID,
+			)
+		}
+	}
 }
 
 // Disconnect marks this instance as disconnected.
@@ -159,25 +274,133 @@ func (txm *EthTxManager) Disconnect() {
 	txm.connected.UnSet()
 }
 
-// OnNewHead does nothing; exists to comply with interface.
+// OnNewHead records the new head, samples the gas prices of its block and
+// bumps the gas of cancelled transactions that are not yet confirmed.
 func (txm *EthTxManager) OnNewHead(head *models.Head) {
 	txm.currentHead = *head
+	txm.sampleGasPricesInBackground(head)
+	txm.bumpCancelledTxsInBackground()
+}
+
+// sampleGasPricesInBackground fetches the head's block without holding up the
+// head tracker, skipping the head if the previous one is still being sampled.
+func (txm *EthTxManager) sampleGasPricesInBackground(head *models.Head) {
+	if txm.config.EthGasPriceBlockHistory() == 0 {
+		return
+	}
+	if !txm.samplingGasPrices.SetToIf(false, true) {
+		return
+	}
+	go func() {
+		defer txm.samplingGasPrices.UnSet()
+		txm.gasPriceEstimator.OnNewHead(head)
+	}()
+}
+
+// bumpCancelledTxsInBackground monitors the self-sends that replaced
+// cancelled transactions, bumping their gas until they are confirmed. Their
+// runs are cancelled, so nothing else would stop them blocking their nonce.
+// The head is skipped if the previous one is still being handled.
+func (txm *EthTxManager) bumpCancelledTxsInBackground() {
+	if !txm.Connected() || !txm.bumpingCancelledTxs.SetToIf(false, true) {
+		return
+	}
+	go func() {
+		defer txm.bumpingCancelledTxs.UnSet()
+		txm.bumpCancelledTxs()
+	}()
+}
+
+func (txm *EthTxManager) bumpCancelledTxs() {
+	attempts, err := txm.orm.UnconfirmedTxAttempts()
+	if err != nil {
+		logger.Errorw("Unable to load unconfirmed transactions", "error", err)
+		return
+	}
+
+	for _, attempt := range models.HighestPricedTxAttemptPerTx(attempts) {
+		if attempt.Tx == nil || !attempt.Tx.IsCancellation() {
+			continue
+		}
+		if _, _, err := txm.BumpGasUntilSafe(attempt.Hash); err != nil {
+			logger.Warnw(
+				fmt.Sprintf("Unable to bump gas of cancelled Tx #%d", attempt.TxID),
+				"txID", attempt.TxID,
+				"txHash", attempt.Hash.Hex(),
+				"error", err,
+			)
+		}
+	}
+}
+
+// OnReorg re-validates transactions that may have been mined in one of the
+// orphaned blocks, marking those no longer on the main chain as unconfirmed
+// so that they are monitored and bumped again, and rebroadcasts every
+// unconfirmed transaction in case it was dropped by the ethereum node.
+func (txm *EthTxManager) OnReorg(ancestor *models.Head, oldHeads, newHeads []models.Head) {
+	if len(newHeads) > 0 {
+		txm.currentHead = newHeads[len(newHeads)-1]
+	}
+	if ancestor == nil {
+		return
+	}
+
+	// A transaction mined in an orphaned block may have been sent well before
+	// the common ancestor, so look back over the whole retained head history.
+	sentAfter := ancestor.Number - models.HeadsRetained
+	if sentAfter < 0 {
+		sentAfter = 0
+	}
+
+	txs, err := txm.orm.ConfirmedTxsSentAfter(uint64(sentAfter))
+	if err != nil {
+		logger.Errorw("Unable to load confirmed transactions after reorg", "error", err)
+		return
+	}
+
+	for _, tx := range txs {
+		receipt, err := txm.GetTxReceipt(tx.Hash)
+		if err != nil {
+			logger.Warnw("Unable to fetch receipt after reorg", "txHash", tx.Hash.Hex(), "txID", tx.ID, "error", err)
+			continue
+		}
+		if !receipt.Unconfirmed() {
+			continue
+		}
+
+		logger.Warnw(
+			fmt.Sprintf("Tx #%d is no longer confirmed after chain reorganization", tx.ID),
+			"txHash", tx.Hash.Hex(),
+			"txID", tx.ID,
+			"nonce", tx.Nonce,
+		)
+		if err := txm.orm.MarkTxUnconfirmed(&tx); err != nil {
+			logger.Errorw("Unable to mark transaction unconfirmed", "txID", tx.ID, "error", err)
+		}
+	}
+
+	logger.WarnIf(txm.rebroadcastUnconfirmedTxs())
 }
 
-// CreateTx signs and sends a transaction to the Ethereum blockchain.
+// CreateTx signs and sends a transaction to the Ethereum blockchain, with
+// an estimated gas price and gas limit.
 func (txm *EthTxManager) CreateTx(to common.Address, data []byte) (*models.Tx, error) {
-	return txm.CreateTxWithGas(null.String{}, to, data, txm.config.EthGasPriceDefault(), DefaultGasLimit)
+	return txm.CreateTxWithGas(null.String{}, to, data, nil, 0, nil)
 }
 
 // CreateTxWithGas signs and sends a transaction to the Ethereum blockchain.
-func (txm *EthTxManager) CreateTxWithGas(surrogateID null.String, to common.Address, data []byte, gasPriceWei *big.Int, gasLimit uint64) (*models.Tx, error) {
-	ma, err := txm.nextAccount()
+// A nil gas price uses the estimated gas price, and a zero gas limit uses the
+// node's gas estimate for the transaction. When fromAddresses are given, the
+// transaction is sent from one of them in round robin, otherwise from any
+// available account.
+func (txm *EthTxManager) CreateTxWithGas(surrogateID null.String, to common.Address, data []byte, gasPriceWei *big.Int, gasLimit uint64, fromAddresses []common.Address) (*models.Tx, error) {
+	ma, err := txm.NextAccount(fromAddresses)
 	if err != nil {
 		return nil, err
 	}
 
-	gasPriceWei, gasLimit = normalizeGasParams(gasPriceWei, gasLimit, txm.config)
-	return txm.createTx(surrogateID, ma, to, data, gasPriceWei, gasLimit, nil)
+	gasPriceWei, gasLimit, gasEstimate := txm.gasParams(ma.Address, to, data, gasPriceWei, gasLimit)
+	return txm.createTx(surrogateID, ma, to, data, gasPriceWei, gasLimit, gasEstimate, nil)
 }
 
 // CreateTxWithEth signs and sends a transaction with some ETH to transfer.
@@ -187,12 +410,72 @@ func (txm *EthTxManager) CreateTxWithEth(from, to common.Address, value *assets.
 		return nil, errors.New("account does not exist")
 	}
 
-	return txm.createTx(null.String{}, ma, to, []byte{}, txm.config.EthGasPriceDefault(), DefaultGasLimit, value)
+	return txm.createTx(null.String{}, ma, to, []byte{}, txm.gasPriceEstimator.GasPrice(), DefaultGasLimit, 0, value)
+}
+
+// CancelTx replaces the unconfirmed transaction with an attempt of the given
+// hash by a zero value transfer from its sender to itself, with the same nonce
+// and a bumped gas price. The replacement is added as a new attempt on the
+// same transaction, and frees the nonce for later transactions once mined.
+// The replacement is bumped on each new head until it is confirmed.
+func (txm *EthTxManager) CancelTx(hash common.Hash) (*models.Tx, error) {
+	if !txm.Connected() {
+		return nil, errors.Wrap(ErrPendingConnection, "EthTxManager#CancelTx")
+	}
+
+	txm.attemptsMutex.Lock()
+	defer txm.attemptsMutex.Unlock()
+
+	tx, _, err := txm.orm.FindTxByAttempt(hash)
+	if err != nil {
+		return nil, errors.Wrap(err, "CancelTx FindTxByAttempt")
+	} else if tx.Confirmed {
+		return nil, ErrTxConfirmed
+	}
+
+	highestGasPrice := big.NewInt(0)
+	for _, attempt := range tx.Attempts {
+		if attempt.GasPrice.ToInt().Cmp(highestGasPrice) > 0 {
+			highestGasPrice = attempt.GasPrice.ToInt()
+		}
+	}
+	gasPrice := capGasPrice(bumpGasPrice(highestGasPrice, txm.config), txm.config)
+	if gasPrice.Cmp(highestGasPrice) <= 0 {
+		return nil, fmt.Errorf("cannot bump gas price of %v above ETH_MAX_GAS_PRICE_WEI to replace it", highestGasPrice)
+	}
+
+	tx.To = tx.From
+	tx.Data = []byte{}
+	tx.Value = utils.NewBig(big.NewInt(0))
+	tx.GasLimit = params.TxGas
+	if _, err := txm.createAttempt(tx, gasPrice, uint64(txm.currentHead.Number)); err != nil {
+		return nil, errors.Wrap(err, "CancelTx createAttempt")
+	}
+
+	logger.Infow(
+		fmt.Sprintf("Cancelling Tx #%d with a self-send at gas price %v", tx.ID, gasPrice),
+		"txID", tx.ID,
+		"nonce", tx.Nonce,
+		"from", tx.From.Hex(),
+		"newTxHash", tx.Hash.Hex(),
+	)
+	return tx, nil
 }
 
-func (txm *EthTxManager) nextAccount() (*ManagedAccount, error) {
+// NextAccount uses round robin to select the account to send the next
+// transaction from, among the fromAddresses if any are given, or else among
+// all available accounts.
+func (txm *EthTxManager) NextAccount(fromAddresses []common.Address) (*ManagedAccount, error) {
 	if !txm.Connected() {
-		return nil, errors.Wrap(ErrPendingConnection, "EthTxManager#nextAccount")
+		return nil, errors.Wrap(ErrPendingConnection, "EthTxManager#NextAccount")
+	}
+
+	if len(fromAddresses) > 0 {
+		ma := txm.nextActiveAccountFrom(fromAddresses)
+		if ma == nil {
+			return nil, fmt.Errorf("none of the from addresses %v are active accounts", fromAddresses)
+		}
+		return ma, nil
 	}
 
 	ma := txm.NextActiveAccount()
@@ -203,20 +486,63 @@ func (txm *EthTxManager) nextAccount() (*ManagedAccount, error) {
 	return ma, nil
 }
 
-func normalizeGasParams(gasPriceWei *big.Int, gasLimit uint64, config orm.ConfigReader) (*big.Int, uint64) {
-	if !config.Dev() {
-		return config.EthGasPriceDefault(), DefaultGasLimit
+// gasParams returns the gas price and limit to send a transaction with, along
+// with the node's gas estimate for it, which is zero if the gas could not be
+// estimated.
+//
+// Without a gas price given, the gas price is estimated from recent blocks.
+// Either way it is capped at ETH_MAX_GAS_PRICE_WEI. Without a gas limit given, the estimate multiplied by
+// ETH_GAS_ESTIMATE_MULTIPLIER is used, falling back to DefaultGasLimit. Either
+// way the gas limit is kept within ETH_GAS_LIMIT_MIN and ETH_GAS_LIMIT_MAX.
+func (txm *EthTxManager) gasParams(
+	from common.Address,
+	to common.Address,
+	data []byte,
+	gasPriceWei *big.Int,
+	gasLimit uint64,
+) (*big.Int, uint64, uint64) {
+	if gasPriceWei == nil {
+		gasPriceWei = txm.gasPriceEstimator.GasPrice()
 	}
+	gasPriceWei = capGasPrice(gasPriceWei, txm.config)
 
-	if gasPriceWei == nil {
-		gasPriceWei = config.EthGasPriceDefault()
+	gasEstimate, err := txm.EstimateGas(eth.CallArgs{From: &from, To: to, Data: data})
+	if err != nil {
+		logger.Warnw("Unable to estimate gas for transaction", "from", from.Hex(), "to", to.Hex(), "error", err)
+		gasEstimate = 0
 	}
 
 	if gasLimit == 0 {
 		gasLimit = DefaultGasLimit
+		if gasEstimate > 0 {
+			gasLimit = uint64(float64(gasEstimate) * txm.config.EthGasEstimateMultiplier())
+		}
+	} else if gasLimit < gasEstimate {
+		logger.Warnw(
+			"Gas limit given for transaction is below the node's gas estimate",
+			"to", to.Hex(),
+			"gasLimit", gasLimit,
+			"gasEstimate", gasEstimate,
+		)
 	}
 
-	return gasPriceWei, gasLimit
+	return gasPriceWei, boundGasLimit(gasLimit, txm.config), gasEstimate
+}
+
+// boundGasLimit keeps the gas limit within ETH_GAS_LIMIT_MIN and
+// ETH_GAS_LIMIT_MAX.
+func boundGasLimit(gasLimit uint64, config orm.ConfigReader) uint64 {
+	bounded := gasLimit
+	if max := config.EthGasLimitMax(); bounded > max {
+		bounded = max
+	}
+	if min := config.EthGasLimitMin(); bounded < min {
+		bounded = min
+	}
+	if bounded != gasLimit {
+		logger.Warnw("Gas limit is out of bounds, bounding it", "gasLimit", gasLimit, "boundedGasLimit", bounded)
+	}
+	return bounded
 }
 
 // createTx creates an ethereum transaction, and retries to submit the
@@ -228,10 +554,15 @@ func (txm *EthTxManager) createTx(
 	data []byte,
 	gasPriceWei *big.Int,
 	gasLimit uint64,
+	gasEstimate uint64,
 	value *assets.Eth) (*models.Tx, error) {
 
+	if err := checkAffordable(ma, gasPriceWei, gasLimit, value); err != nil {
+		return nil, err
+	}
+
 	for nrc := 0; nrc <= nonceReloadLimit; nrc++ {
-		tx, err := txm.sendInitialTx(surrogateID, ma, to, data, gasPriceWei, gasLimit, value)
+		tx, err := txm.sendInitialTx(surrogateID, ma, to, data, gasPriceWei, gasLimit, gasEstimate, value)
 		if err == nil {
 			return tx, nil
 		}
@@ -257,6 +588,25 @@ func (txm *EthTxManager) createTx(
 	)
 }
 
+// checkAffordable returns an InsufficientEthError if the account's last known
+// ETH balance cannot pay for the transaction's value and the gas limit at the
+// given gas price. Accounts whose balance is not known yet are not checked.
+func checkAffordable(ma *ManagedAccount, gasPriceWei *big.Int, gasLimit uint64, value *assets.Eth) error {
+	balance := ma.EthBalance()
+	if balance == nil {
+		return nil
+	}
+
+	cost := new(big.Int).Mul(gasPriceWei, new(big.Int).SetUint64(gasLimit))
+	if value != nil {
+		cost.Add(cost, value.ToInt())
+	}
+	if balance.ToInt().Cmp(cost) < 0 {
+		return InsufficientEthError{Address: ma.Address, Balance: balance, Cost: (*assets.Eth)(cost)}
+	}
+	return nil
+}
+
 // sendInitialTx creates the initial Tx record + attempt for an Ethereum Tx,
 // there should only ever be one of those for a "job"
 func (txm *EthTxManager) sendInitialTx(
@@ -266,6 +616,7 @@ func (txm *EthTxManager) sendInitialTx(
 	data []byte,
 	gasPriceWei *big.Int,
 	gasLimit uint64,
+	gasEstimate uint64,
 	value *assets.Eth) (*models.Tx, error) {
 
 	var err error
@@ -289,6 +640,7 @@ func (txm *EthTxManager) sendInitialTx(
 		}
 
 		tx.SurrogateID = surrogateID
+		tx.GasEstimate = gasEstimate
 		tx, err = txm.orm.CreateTx(tx)
 		if err != nil {
 			return errors.Wrap(err, "TxManager#sendInitialTx CreateTx")
@@ -369,8 +721,13 @@ func (txm *EthTxManager) GetLINKBalance(address common.Address) (*assets.Link, e
 }
 
 // BumpGasUntilSafe process a collection of related TxAttempts, trying to get
-// at least one TxAttempt into a safe state, bumping gas if needed
+// at least one TxAttempt into a safe state, bumping gas if needed. It holds
+// the attempts mutex so that a transaction being cancelled is not bumped with
+// its original recipient and data.
 func (txm *EthTxManager) BumpGasUntilSafe(hash common.Hash) (*eth.TxReceipt, AttemptState, error) {
+	txm.attemptsMutex.Lock()
+	defer txm.attemptsMutex.Unlock()
+
 	tx, _, err := txm.orm.FindTxByAttempt(hash)
 	if err != nil {
 		return nil, Unknown, errors.Wrap(err, "BumpGasUntilSafe FindTxByAttempt")
@@ -402,7 +759,7 @@ func (txm *EthTxManager) checkChainForConfirmation(tx *models.Tx) (*eth.TxReceip
 }
 
 func (txm *EthTxManager) checkAccountForConfirmation(tx *models.Tx) (*eth.TxReceipt, AttemptState, error) {
-	ma := txm.GetAvailableAccount(tx.From)
+	ma := txm.getAccount(tx.From)
 
 	if ma != nil && ma.lastSafeNonce > tx.Nonce {
 		tx.Confirmed = true
@@ -418,12 +775,7 @@ func (txm *EthTxManager) checkAccountForConfirmation(tx *models.Tx) (*eth.TxRece
 
 // GetAvailableAccount retrieves a managed account if it one matches the address given.
 func (txm *EthTxManager) GetAvailableAccount(from common.Address) *ManagedAccount {
-	for _, a := range txm.availableAccounts {
-		if a.Address == from {
-			return a
-		}
-	}
-	return nil
+	return txm.getAccount(from)
 }
 
 // ContractLINKBalance returns the balance for the contract associated with this
@@ -578,7 +930,7 @@ func (txm *EthTxManager) processAttempt(
 		attemptLimit := txm.config.TxAttemptLimit()
 		if attemptIndex >= int(attemptLimit) {
 			logger.Warnw(
-				fmt.Sprintf("Tx #%d is %s, has met TxAttemptLimit", attemptIndex, state),
+				fmt.Sprintf("Tx #%d is %s, has met TxAttemptLimit. Later transactions from %s are blocked until it is mined or cancelled with `chainlink txs cancel`", attemptIndex, state, tx.From.Hex()),
 				"txAttemptLimit", attemptLimit,
 				"txHash", txAttempt.Hash.String(),
 				"txID", txAttempt.TxID,
@@ -670,12 +1022,23 @@ func (txm *EthTxManager) handleSafe(
 	return nil
 }
 
-// bumpGas creates a new transaction attempt with an increased gas cost
+// bumpGas creates a new transaction attempt with an increased gas cost,
+// unless the gas price has already reached ETH_MAX_GAS_PRICE_WEI.
 func (txm *EthTxManager) bumpGas(tx *models.Tx, attemptIndex int, blockHeight uint64) error {
 	txAttempt := tx.Attempts[attemptIndex]
 
 	originalGasPrice := txAttempt.GasPrice.ToInt()
-	bumpedGasPrice := new(big.Int).Add(originalGasPrice, txm.config.EthGasBumpWei())
+	bumpedGasPrice := capGasPrice(bumpGasPrice(originalGasPrice, txm.config), txm.config)
+	if bumpedGasPrice.Cmp(originalGasPrice) <= 0 {
+		logger.Warnw(
+			fmt.Sprintf("Tx #%d has reached the maximum gas price, not bumping gas", tx.ID),
+			"txHash", txAttempt.Hash.String(),
+			"txID", txAttempt.TxID,
+			"gasPrice", originalGasPrice,
+			"maxGasPrice", txm.config.EthMaxGasPriceWei(),
+		)
+		return nil
+	}
 
 	bumpedTxAttempt, err := txm.createAttempt(tx, bumpedGasPrice, blockHeight)
 	if err != nil {
@@ -689,6 +1052,18 @@ func (txm *EthTxManager) bumpGas(tx *models.Tx, attemptIndex int, blockHeight ui
 	return nil
 }
 
+// bumpGasPrice returns the gas price increased by ETH_GAS_BUMP_WEI or by
+// ETH_GAS_BUMP_PERCENT, whichever is more.
+func bumpGasPrice(gasPriceWei *big.Int, config orm.ConfigReader) *big.Int {
+	byWei := new(big.Int).Add(gasPriceWei, config.EthGasBumpWei())
+	byPercent := new(big.Int).Mul(gasPriceWei, big.NewInt(int64(100+config.EthGasBumpPercent())))
+	byPercent.Div(byPercent, big.NewInt(100))
+	if byPercent.Cmp(byWei) > 0 {
+		return byPercent
+	}
+	return byWei
+}
+
 // createAttempt adds a new transaction attempt to a transaction record
 func (txm *EthTxManager) createAttempt(
 	tx *models.Tx,
@@ -744,6 +1119,27 @@ func (txm *EthTxManager) NextActiveAccount() *ManagedAccount {
 	return account
 }
 
+// nextActiveAccountFrom uses round robin to select a managed account from
+// the available accounts that are among the allowed addresses, or returns nil
+// if none of them are available.
+func (txm *EthTxManager) nextActiveAccountFrom(allowed []common.Address) *ManagedAccount {
+	txm.accountsMutex.Lock()
+	defer txm.accountsMutex.Unlock()
+
+	count := len(txm.availableAccounts)
+	for i := 0; i < count; i++ {
+		idx := (txm.availableAccountIdx + i) % count
+		account := txm.availableAccounts[idx]
+		for _, address := range allowed {
+			if account.Address == address {
+				txm.availableAccountIdx = (idx + 1) % count
+				return account
+			}
+		}
+	}
+	return nil
+}
+
 func (txm *EthTxManager) getAccount(from common.Address) *ManagedAccount {
 	txm.accountsMutex.Lock()
 	defer txm.accountsMutex.Unlock()
@@ -775,12 +1171,30 @@ type ManagedAccount struct {
 	nonce         uint64
 	lastSafeNonce uint64
 	mutex         *sync.Mutex
+	ethBalance    *assets.Eth
+	balanceMutex  *sync.RWMutex
 }
 
 // NewManagedAccount creates a managed account that handles nonce increments
 // locally.
 func NewManagedAccount(a accounts.Account, nonce uint64) *ManagedAccount {
-	return &ManagedAccount{Account: a, nonce: nonce, mutex: &sync.Mutex{}}
+	return &ManagedAccount{Account: a, nonce: nonce, mutex: &sync.Mutex{}, balanceMutex: &sync.RWMutex{}}
+}
+
+// EthBalance returns the last known ETH balance of the account, or nil if it
+// has not been checked yet.
+func (a *ManagedAccount) EthBalance() *assets.Eth {
+	a.balanceMutex.RLock()
+	defer a.balanceMutex.RUnlock()
+	return a.ethBalance
+}
+
+// SetEthBalance records the ETH balance of the account, which transactions
+// sent from it are checked against.
+func (a *ManagedAccount) SetEthBalance(balance *assets.Eth) {
+	a.balanceMutex.Lock()
+	defer a.balanceMutex.Unlock()
+	a.ethBalance = balance
 }
 
 // Nonce returns the client side managed nonce.
@@ -1,8 +1,11 @@
 package store
 
 import (
+	"math/big"
+	"sync"
 	"testing"
 
+	"chainlink/core/assets"
 	"chainlink/core/store/models"
 
 	"github.com/ethereum/go-ethereum/accounts"
@@ -71,3 +74,34 @@ func TestTxManager_updateLastSafeNonce_noMatchingAccount(t *testing.T) {
 
 	assert.NotEqual(t, nonce, ma.lastSafeNonce)
 }
+
+func TestTxManager_checkAffordable(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		balance *assets.Eth
+		value   *assets.Eth
+		wantErr bool
+	}{
+		{"unknown balance", nil, nil, false},
+		{"exactly affordable", assets.NewEth(21000 * 10), nil, false},
+		{"too low for gas", assets.NewEth(21000*10 - 1), nil, true},
+		{"too low for value", assets.NewEth(21000 * 10), assets.NewEth(1), true},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			ma := &ManagedAccount{balanceMutex: &sync.RWMutex{}}
+			ma.SetEthBalance(test.balance)
+
+			err := checkAffordable(ma, big.NewInt(10), 21000, test.value)
+			if test.wantErr {
+				assert.IsType(t, InsufficientEthError{}, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
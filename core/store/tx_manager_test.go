@@ -19,6 +19,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/onsi/gomega"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -32,6 +33,7 @@ func TestTxManager_CreateTx_Success(t *testing.T) {
 	defer cleanup()
 
 	ethClient := new(mocks.Client)
+	ethClient.On("EstimateGas", mock.Anything).Return(uint64(0), errors.New("gas required exceeds allowance"))
 
 	config := cltest.NewTestConfig(t)
 	keyStore := strpkg.NewKeyStore(config.KeysDir())
@@ -76,6 +78,7 @@ func TestTxManager_CreateTx_RoundRobinSuccess(t *testing.T) {
 	defer cleanup()
 
 	ethClient := new(mocks.Client)
+	ethClient.On("EstimateGas", mock.Anything).Return(uint64(0), errors.New("gas required exceeds allowance"))
 
 	config := cltest.NewTestConfig(t)
 	keyStore := strpkg.NewKeyStore(config.KeysDir())
@@ -112,6 +115,7 @@ func TestTxManager_CreateTx_RoundRobinSuccess(t *testing.T) {
 	require.NoError(t, err)
 	assert.Len(t, ntx.Attempts, 1)
 
+	ethClient.On("GetBlockWithTransactions", mock.Anything).Return(eth.Block{}, nil)
 	manager.OnNewHead(cltest.Head(bumpAt))
 	ethClient.On("GetTxReceipt", createdTx1.Attempts[0].Hash).Return(&eth.TxReceipt{}, nil)
 	ethClient.On("SendRawTx", mock.Anything).Return(cltest.NewHash(), nil)
@@ -139,6 +143,52 @@ func TestTxManager_CreateTx_RoundRobinSuccess(t *testing.T) {
 	ethClient.AssertExpectations(t)
 }
 
+func TestTxManager_CreateTxWithGas_FromAddresses(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	ethClient := new(mocks.Client)
+	ethClient.On("EstimateGas", mock.Anything).Return(uint64(0), errors.New("gas required exceeds allowance"))
+
+	config := cltest.NewTestConfig(t)
+	keyStore := strpkg.NewKeyStore(config.KeysDir())
+
+	// Add three accounts
+	for i := 0; i < 3; i++ {
+		_, err := keyStore.NewAccount(cltest.Password)
+		require.NoError(t, err)
+	}
+	require.NoError(t, keyStore.Unlock(cltest.Password))
+
+	manager := strpkg.NewEthTxManager(ethClient, config, keyStore, store.ORM)
+	manager.Register(keyStore.Accounts())
+
+	ethClient.On("GetNonce", mock.Anything).Return(uint64(256), nil).Times(3)
+	require.NoError(t, manager.Connect(cltest.Head(1)))
+
+	ethClient.On("SendRawTx", mock.Anything).Return(cltest.NewHash(), nil)
+
+	accounts := keyStore.Accounts()
+	fromAddresses := []common.Address{accounts[1].Address, accounts[2].Address}
+	to := cltest.NewAddress()
+	data := hexutil.MustDecode("0x0000abcdef")
+
+	var senders []common.Address
+	for i := 0; i < 3; i++ {
+		tx, err := manager.CreateTxWithGas(null.String{}, to, data, nil, 0, fromAddresses)
+		require.NoError(t, err)
+		senders = append(senders, tx.From)
+	}
+	assert.Equal(t, []common.Address{accounts[1].Address, accounts[2].Address, accounts[1].Address}, senders)
+
+	_, err := manager.CreateTxWithGas(null.String{}, to, data, nil, 0, []common.Address{cltest.NewAddress()})
+	assert.Error(t, err, "should not send from an unregistered account")
+
+	ethClient.AssertExpectations(t)
+}
+
 func TestTxManager_CreateTx_BreakTxAttemptLimit(t *testing.T) {
 	t.Parallel()
 
@@ -146,6 +196,7 @@ func TestTxManager_CreateTx_BreakTxAttemptLimit(t *testing.T) {
 	defer cleanup()
 
 	ethClient := new(mocks.Client)
+	ethClient.On("EstimateGas", mock.Anything).Return(uint64(0), errors.New("gas required exceeds allowance"))
 
 	config := cltest.NewTestConfig(t)
 	config.Set("CHAINLINK_TX_ATTEMPT_LIMIT", 1)
@@ -185,6 +236,7 @@ func TestTxManager_CreateTx_BreakTxAttemptLimit(t *testing.T) {
 	assert.Equal(t, nonce, ntx.Nonce)
 	assert.Len(t, ntx.Attempts, 1)
 
+	ethClient.On("GetBlockWithTransactions", mock.Anything).Return(eth.Block{}, nil)
 	manager.OnNewHead(cltest.Head(bumpAt))
 	ethClient.On("GetTxReceipt", mock.Anything).Once().Return(&eth.TxReceipt{}, nil)
 	ethClient.On("SendRawTx", mock.Anything).Return(tx.Attempts[0].Hash, nil)
@@ -281,6 +333,7 @@ func TestTxManager_CreateTx_NonceTooLowReloadSuccess(t *testing.T) {
 			defer cleanup()
 
 			ethClient := new(mocks.Client)
+			ethClient.On("EstimateGas", mock.Anything).Return(uint64(0), errors.New("gas required exceeds allowance"))
 			config := cltest.NewTestConfig(t)
 			require.NoError(t, utils.JustError(store.KeyStore.NewAccount(cltest.Password)))
 			require.NoError(t, store.KeyStore.Unlock(cltest.Password))
@@ -322,6 +375,7 @@ func TestTxManager_CreateTx_NonceTooLowReloadLimit(t *testing.T) {
 	defer cleanup()
 
 	ethClient := new(mocks.Client)
+	ethClient.On("EstimateGas", mock.Anything).Return(uint64(0), errors.New("gas required exceeds allowance"))
 
 	config := cltest.NewTestConfig(t)
 	keyStore := strpkg.NewKeyStore(config.KeysDir())
@@ -472,6 +526,56 @@ func TestTxManager_BumpGasUntilSafe_exceedsGasBumpThreshold(t *testing.T) {
 	ethMock.EventuallyAllCalled(t)
 }
 
+func TestTxManager_BumpGasUntilSafe_maxGasPrice(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		maxGasPrice      int64
+		wantAttempts     int
+		wantLastGasPrice int64
+	}{
+		{"capped at max", 3, 2, 3},
+		{"already at max", 1, 1, 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			app, cleanup := cltest.NewApplicationWithKey(t)
+			defer cleanup()
+
+			store := app.Store
+			config := store.Config
+			config.Set("ETH_MAX_GAS_PRICE_WEI", test.maxGasPrice)
+
+			txm := store.TxManager
+			from := cltest.GetAccountAddress(t, store)
+			sentAt := uint64(23456)
+			gasThreshold := sentAt + config.EthGasBumpThreshold()
+			ethMock := app.MockCallerSubscriberClient()
+			ethMock.Register("eth_getTransactionCount", "0x0")
+			ethMock.Register("eth_chainId", config.ChainID())
+			require.NoError(t, app.Store.ORM.CreateHead(cltest.Head(gasThreshold+1)))
+			require.NoError(t, app.StartAndConnect())
+
+			tx := cltest.CreateTx(t, store, from, sentAt)
+			require.Greater(t, len(tx.Attempts), 0)
+
+			ethMock.Register("eth_getTransactionReceipt", eth.TxReceipt{})
+			ethMock.Register("eth_sendRawTransaction", cltest.NewHash())
+
+			_, state, err := txm.BumpGasUntilSafe(tx.Attempts[0].Hash)
+			assert.NoError(t, err)
+			assert.Equal(t, strpkg.Unconfirmed, state)
+
+			tx, err = store.FindTx(tx.ID)
+			require.NoError(t, err)
+			require.Len(t, tx.Attempts, test.wantAttempts)
+			assert.Equal(t, big.NewInt(test.wantLastGasPrice), tx.Attempts[len(tx.Attempts)-1].GasPrice.ToInt())
+		})
+	}
+}
+
 func TestTxManager_BumpGasUntilSafe_confirmed(t *testing.T) {
 	t.Parallel()
 
@@ -693,6 +797,28 @@ func TestTxManager_BumpGasUntilSafe_erroring(t *testing.T) {
 	}
 }
 
+func TestTxManager_CancelTx_AtMaxGasPrice(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKey(t)
+	defer cleanup()
+	app.Config.Set("ETH_MAX_GAS_PRICE_WEI", 1)
+	_, err := app.MockStartAndConnect()
+	require.NoError(t, err)
+
+	store := app.Store
+	from := cltest.GetAccountAddress(t, store)
+	tx := cltest.CreateTx(t, store, from, 1)
+
+	_, err = store.TxManager.CancelTx(tx.Hash)
+	assert.EqualError(t, err, "cannot bump gas price of 1 above ETH_MAX_GAS_PRICE_WEI to replace it")
+
+	tx, err = store.FindTx(tx.ID)
+	require.NoError(t, err)
+	assert.Len(t, tx.Attempts, 1)
+	assert.NotEqual(t, from, tx.To)
+}
+
 func TestTxManager_CheckAttempt(t *testing.T) {
 	t.Parallel()
 
@@ -841,6 +967,47 @@ func TestTxManager_NextActiveAccount_RoundRobin(t *testing.T) {
 	assert.Equal(t, a0, a2)
 }
 
+func TestTxManager_Register_WhileConnected(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	ethMock := &cltest.EthMock{}
+	txm := strpkg.NewEthTxManager(
+		&eth.CallerSubscriberClient{CallerSubscriber: ethMock},
+		orm.NewConfig(),
+		nil,
+		store.ORM,
+	)
+
+	accounts := []accounts.Account{
+		accounts.Account{Address: common.HexToAddress("0xbf4ed7b27f1d666546e30d74d50d173d20bca001")},
+		accounts.Account{Address: common.HexToAddress("0xbf4ed7b27f1d666546e30d74d50d173d20bca002")},
+	}
+
+	ethMock.Register("eth_getTransactionCount", `0x1D0`)
+	txm.Register(accounts[:1])
+	require.NoError(t, txm.Connect(cltest.Head(1)))
+	ethMock.EventuallyAllCalled(t)
+
+	ethMock.Register("eth_getTransactionCount", `0x2D0`)
+	txm.Register(accounts)
+	ethMock.EventuallyAllCalled(t)
+
+	a0 := txm.GetAvailableAccount(accounts[0].Address)
+	require.NotNil(t, a0)
+	a1 := txm.GetAvailableAccount(accounts[1].Address)
+	require.NotNil(t, a1, "newly registered account should be activated")
+	assert.Equal(t, uint64(0x2d0), a1.Nonce())
+
+	txm.Register(accounts[1:])
+	assert.Nil(t, txm.GetAvailableAccount(accounts[0].Address), "unregistered account should no longer be available")
+	assert.Equal(t, a1, txm.GetAvailableAccount(accounts[1].Address), "still registered account should keep its nonce")
+	assert.Equal(t, a1, txm.NextActiveAccount())
+	assert.Equal(t, a1, txm.NextActiveAccount())
+}
+
 func TestTxManager_ReloadNonce(t *testing.T) {
 	t.Parallel()
 
@@ -976,6 +1143,7 @@ func TestTxManager_LogsETHAndLINKBalancesAfterSuccessfulTx(t *testing.T) {
 	defer cleanup()
 
 	ethClient := new(mocks.Client)
+	ethClient.On("EstimateGas", mock.Anything).Return(uint64(0), errors.New("gas required exceeds allowance"))
 
 	config := cltest.NewTestConfig(t)
 	keyStore := strpkg.NewKeyStore(config.KeysDir())
@@ -1017,6 +1185,7 @@ func TestTxManager_LogsETHAndLINKBalancesAfterSuccessfulTx(t *testing.T) {
 		Hash:        tx.Attempts[0].Hash,
 		BlockNumber: cltest.Int(sentAt),
 	}
+	ethClient.On("GetBlockWithTransactions", mock.Anything).Return(eth.Block{}, nil)
 	manager.OnNewHead(cltest.Head(confirmedAt))
 	ethClient.On("GetTxReceipt", tx.Attempts[0].Hash).Return(&confirmedReceipt, nil)
 	ethClient.On("GetERC20Balance", from, mock.Anything).Return(nil, nil)
@@ -1052,35 +1221,45 @@ func TestTxManager_CreateTxWithGas(t *testing.T) {
 	assert.NoError(t, app.StartAndConnect())
 
 	customGasPrice := utils.NewBig(big.NewInt(1337))
-	customGasLimit := uint64(10009)
+	customGasLimit := uint64(100009)
 
 	defaultGasPrice := utils.NewBig(config.EthGasPriceDefault())
+	config.Set("ETH_GAS_ESTIMATE_MULTIPLIER", 1.5)
+	config.Set("ETH_GAS_LIMIT_MIN", 50000)
+	config.Set("ETH_GAS_LIMIT_MAX", 1000000)
 
 	tests := []struct {
-		name             string
-		dev              bool
-		gasPrice         *utils.Big
-		gasLimit         uint64
-		expectedGasPrice *utils.Big
-		expectedGasLimit uint64
+		name                string
+		gasPrice            *utils.Big
+		gasLimit            uint64
+		gasEstimate         string
+		expectedGasPrice    *utils.Big
+		expectedGasLimit    uint64
+		expectedGasEstimate uint64
 	}{
-		{"dev", true, customGasPrice, customGasLimit, customGasPrice, customGasLimit},
-		{"dev but not set", true, nil, 0, defaultGasPrice, strpkg.DefaultGasLimit},
-		{"not dev", false, customGasPrice, customGasLimit, defaultGasPrice, strpkg.DefaultGasLimit},
-		{"not dev not set", false, nil, 0, defaultGasPrice, strpkg.DefaultGasLimit},
+		{"set", customGasPrice, customGasLimit, "0x186a0", customGasPrice, customGasLimit, 100000},
+		{"not set", nil, 0, "0x186a0", defaultGasPrice, 150000, 100000},
+		{"not set, estimate failed", nil, 0, "", defaultGasPrice, strpkg.DefaultGasLimit, 0},
+		{"set below minimum", customGasPrice, 21000, "0x5208", customGasPrice, 50000, 21000},
+		{"set above maximum", customGasPrice, 5000000, "0x186a0", customGasPrice, 1000000, 100000},
+		{"estimate above maximum", nil, 0, "0xf4240", defaultGasPrice, 1000000, 1000000},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			config.Set("CHAINLINK_DEV", test.dev)
-
 			ethMock.Context("manager.CreateTx", func(ethMock *cltest.EthMock) {
+				if test.gasEstimate == "" {
+					ethMock.RegisterError("eth_estimateGas", "gas required exceeds allowance")
+				} else {
+					ethMock.Register("eth_estimateGas", test.gasEstimate)
+				}
 				ethMock.Register("eth_sendRawTransaction", cltest.NewHash())
 			})
 
-			tx, err := manager.CreateTxWithGas(null.String{}, to, data, test.gasPrice.ToInt(), test.gasLimit)
+			tx, err := manager.CreateTxWithGas(null.String{}, to, data, test.gasPrice.ToInt(), test.gasLimit, nil)
 			require.NoError(t, err)
 			assert.Equal(t, test.expectedGasLimit, tx.GasLimit)
+			assert.Equal(t, test.expectedGasEstimate, tx.GasEstimate)
 
 			require.Len(t, tx.Attempts, 1)
 			assert.Equal(t, test.expectedGasPrice, tx.Attempts[0].GasPrice)
@@ -1097,6 +1276,7 @@ func TestTxManager_RebroadcastUnconfirmedTxsOnReconnect(t *testing.T) {
 	defer cleanup()
 
 	ethClient := new(mocks.Client)
+	ethClient.On("EstimateGas", mock.Anything).Return(uint64(0), errors.New("gas required exceeds allowance"))
 
 	config := cltest.NewTestConfig(t)
 	config.Set("CHAINLINK_TX_ATTEMPT_LIMIT", 1)
@@ -1131,3 +1311,86 @@ func TestTxManager_RebroadcastUnconfirmedTxsOnReconnect(t *testing.T) {
 
 	ethClient.AssertExpectations(t)
 }
+
+func TestTxManager_OnNewHead_SamplesGasPricesInBackground(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	config := cltest.NewTestConfig(t)
+	config.Set("ETH_GAS_PRICE_BLOCK_HISTORY", 2)
+	keyStore := strpkg.NewKeyStore(config.KeysDir())
+
+	fetching := make(chan struct{})
+	release := make(chan struct{})
+	ethClient := new(mocks.Client)
+	ethClient.On("GetBlockWithTransactions", "0x1").Return(eth.Block{}, nil).Once().Run(func(mock.Arguments) {
+		close(fetching)
+		<-release
+	})
+	manager := strpkg.NewEthTxManager(ethClient, config, keyStore, store.ORM)
+
+	// Neither head waits for the block to be fetched, and the second head is
+	// skipped as the first is still being sampled
+	cltest.CallbackOrTimeout(t, "new heads handled", func() {
+		manager.OnNewHead(cltest.Head(1))
+		<-fetching
+		manager.OnNewHead(cltest.Head(2))
+	})
+	close(release)
+
+	ethClient.AssertExpectations(t)
+}
+
+func TestTxManager_OnNewHead_BumpsCancelledTxs(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	config := cltest.NewTestConfig(t)
+	config.Set("ETH_GAS_PRICE_BLOCK_HISTORY", 0)
+	keyStore := strpkg.NewKeyStore(config.KeysDir())
+	account, err := keyStore.NewAccount(cltest.Password)
+	require.NoError(t, err)
+	require.NoError(t, keyStore.Unlock(cltest.Password))
+
+	ethClient := new(mocks.Client)
+	ethClient.On("EstimateGas", mock.Anything).Return(uint64(0), errors.New("gas required exceeds allowance"))
+	ethClient.On("GetNonce", mock.Anything).Return(uint64(0), nil).Once()
+	manager := strpkg.NewEthTxManager(ethClient, config, keyStore, store.ORM)
+	manager.Register(keyStore.Accounts())
+
+	sentAt := uint64(1)
+	bumpAt := sentAt + config.EthGasBumpThreshold()
+	require.NoError(t, manager.Connect(cltest.Head(sentAt)))
+
+	ethClient.On("SendRawTx", mock.Anything).Return(cltest.NewHash(), nil).Twice()
+	tx, err := manager.CreateTx(cltest.NewAddress(), []byte{1})
+	require.NoError(t, err)
+	_, err = manager.CancelTx(tx.Hash)
+	require.NoError(t, err)
+
+	// The cancelled transaction's run no longer bumps its gas, so the self-send
+	// replacing it is bumped on new heads
+	bumped := make(chan struct{})
+	ethClient.On("GetTxReceipt", mock.Anything).Return(&eth.TxReceipt{}, nil)
+	ethClient.On("SendRawTx", mock.Anything).Return(cltest.NewHash(), nil).Once().Run(func(mock.Arguments) {
+		close(bumped)
+	})
+	manager.OnNewHead(cltest.Head(bumpAt))
+	cltest.CallbackOrTimeout(t, "cancelled tx bumped", func() {
+		<-bumped
+	})
+
+	gomega.NewGomegaWithT(t).Eventually(func() int {
+		tx, err = store.FindTx(tx.ID)
+		require.NoError(t, err)
+		return len(tx.Attempts)
+	}).Should(gomega.Equal(3))
+	assert.Equal(t, account.Address, tx.To)
+	assert.Empty(t, tx.Data)
+
+	ethClient.AssertExpectations(t)
+}
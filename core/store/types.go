@@ -10,4 +10,9 @@ type HeadTrackable interface {
 	Connect(*models.Head) error
 	Disconnect()
 	OnNewHead(*models.Head)
+	// OnReorg is called when the chain reorganizes, with the latest head
+	// common to both chains, the heads that were orphaned and the heads of
+	// the new canonical chain, each ordered from oldest to newest. OnNewHead
+	// is called with the new latest head afterwards.
+	OnReorg(ancestor *models.Head, oldHeads, newHeads []models.Head)
 }
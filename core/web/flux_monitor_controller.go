@@ -0,0 +1,54 @@
+package web
+
+import (
+	"net/http"
+
+	"chainlink/core/services"
+	"chainlink/core/store/models"
+	"chainlink/core/store/orm"
+	"chainlink/core/store/presenters"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+// FluxMonitorController shows what the flux monitor is doing for a job.
+type FluxMonitorController struct {
+	App services.Application
+}
+
+// Show returns the status of each of a job's flux monitor initiators.
+// Initiators the flux monitor is not checking, such as while disconnected from
+// the Ethereum node, are shown as not connected.
+// Example:
+//  "<application>/specs/:SpecID/flux_monitor"
+func (fmc *FluxMonitorController) Show(c *gin.Context) {
+	if id, err := models.NewIDFromString(c.Param("SpecID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+	} else if j, err := fmc.App.GetStore().FindJob(id); errors.Cause(err) == orm.ErrorNotFound {
+		jsonAPIError(c, http.StatusNotFound, errors.New("JobSpec not found"))
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+	} else if initrs := j.InitiatorsFor(models.InitiatorFluxMonitor); len(initrs) == 0 {
+		jsonAPIError(c, http.StatusNotFound, errors.New("JobSpec has no flux monitor initiators"))
+	} else {
+		jsonAPIResponse(c, fluxMonitorStatuses(initrs, fmc.App.GetFluxMonitor().JobStatus(id)), "flux monitor status")
+	}
+}
+
+func fluxMonitorStatuses(initrs []models.Initiator, statuses []models.FluxMonitorStatus) []presenters.FluxMonitorStatus {
+	byInitiator := map[uint]models.FluxMonitorStatus{}
+	for _, status := range statuses {
+		byInitiator[status.InitiatorID] = status
+	}
+
+	pss := make([]presenters.FluxMonitorStatus, len(initrs))
+	for i, initr := range initrs {
+		status, ok := byInitiator[initr.ID]
+		if !ok {
+			status = models.FluxMonitorStatus{InitiatorID: initr.ID, Address: initr.Address}
+		}
+		pss[i] = presenters.FluxMonitorStatus{FluxMonitorStatus: status}
+	}
+	return pss
+}
@@ -0,0 +1,57 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+
+	"chainlink/core/internal/cltest"
+	"chainlink/core/store/presenters"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFluxMonitorController_Show(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	client := app.NewHTTPClient()
+
+	j := cltest.NewJobWithFluxMonitorInitiator()
+	require.NoError(t, app.Store.CreateJob(&j))
+
+	resp, cleanup := client.Get("/v2/specs/" + j.ID.String() + "/flux_monitor")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var statuses []presenters.FluxMonitorStatus
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &statuses))
+	require.Len(t, statuses, 1)
+	assert.Equal(t, j.Initiators[0].ID, statuses[0].InitiatorID)
+	assert.Equal(t, j.Initiators[0].Address, statuses[0].Address)
+	assert.False(t, statuses[0].Connected)
+}
+
+func TestFluxMonitorController_Show_NotFluxMonitor(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	client := app.NewHTTPClient()
+
+	j := cltest.NewJobWithWebInitiator()
+	require.NoError(t, app.Store.CreateJob(&j))
+
+	resp, cleanup := client.Get("/v2/specs/" + j.ID.String() + "/flux_monitor")
+	defer cleanup()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	resp, cleanup = client.Get("/v2/specs/190AE4CE-40B6-4D60-A3DA-061C5ACD32D0/flux_monitor")
+	defer cleanup()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
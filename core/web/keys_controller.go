@@ -1,13 +1,17 @@
 package web
 
 import (
+	"fmt"
 	"net/http"
 
 	"chainlink/core/services"
 	"chainlink/core/store/models"
 	"chainlink/core/store/presenters"
 
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
 )
 
 // KeysController manages account keys
@@ -15,6 +19,34 @@ type KeysController struct {
 	App services.Application
 }
 
+// Index returns the node's keys with their ETH & LINK balances and the nonce
+// of the next transaction sent from each.
+// Example:
+//  "<application>/keys"
+func (kc *KeysController) Index(c *gin.Context) {
+	store := kc.App.GetStore()
+	keys := []presenters.Key{}
+	for _, account := range store.KeyStore.GetAccounts() {
+		balance := getAccountBalanceFor(c, store, account)
+		if c.IsAborted() {
+			return
+		}
+
+		key := presenters.Key{
+			Address:     balance.Address,
+			EthBalance:  balance.EthBalance,
+			LinkBalance: balance.LinkBalance,
+		}
+		if ma := store.TxManager.GetAvailableAccount(account.Address); ma != nil {
+			nonce := ma.Nonce()
+			key.NextNonce = &nonce
+		}
+		keys = append(keys, key)
+	}
+
+	jsonAPIResponse(c, keys, "keys")
+}
+
 // Create adds a new account
 // Example:
 //  "<application>/keys"
@@ -29,6 +61,106 @@ func (kc *KeysController) Create(c *gin.Context) {
 	} else if err := kc.App.GetStore().SyncDiskKeyStoreToDB(); err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 	} else {
+		kc.App.GetStore().TxManager.Register(kc.App.GetStore().KeyStore.Accounts())
 		jsonAPIResponseWithStatus(c, presenters.NewAccount{Account: &account}, "account", http.StatusCreated)
 	}
 }
+
+// Export returns the encrypted keystore JSON of a key, which can be imported
+// into another node or wallet with the password it was created with, after
+// confirming the password of the node's keys.
+// Example:
+//  "<application>/keys/:address/export"
+func (kc *KeysController) Export(c *gin.Context) {
+	request := models.ExportKeyRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	} else if err := kc.App.GetStore().KeyStore.Unlock(request.CurrentPassword); err != nil {
+		jsonAPIError(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	account, err := kc.findAccount(c.Param("address"))
+	if err != nil {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+
+	keys, err := kc.App.GetStore().Keys()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	for _, key := range keys {
+		if key.Address.Address() == account.Address {
+			c.Data(http.StatusOK, "application/json", []byte(key.JSON.String()))
+			return
+		}
+	}
+	jsonAPIError(c, http.StatusNotFound, fmt.Errorf("key %s has not been synced to the database", account.Address.Hex()))
+}
+
+// Delete removes a key from the node, after which no more transactions are
+// sent from it. The node's only key, and keys with unconfirmed transactions,
+// cannot be deleted.
+// Example:
+//  "<application>/keys/:address"
+func (kc *KeysController) Delete(c *gin.Context) {
+	store := kc.App.GetStore()
+	request := models.DeleteKeyRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	account, err := kc.findAccount(c.Param("address"))
+	if err != nil {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+
+	if len(store.KeyStore.GetAccounts()) == 1 {
+		jsonAPIError(c, http.StatusConflict, errors.New("cannot delete the node's only key"))
+		return
+	}
+
+	attempts, err := store.UnconfirmedTxAttempts()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	for _, attempt := range attempts {
+		if attempt.Tx.From == account.Address {
+			jsonAPIError(c, http.StatusConflict, fmt.Errorf("cannot delete key %s with unconfirmed transactions", account.Address.Hex()))
+			return
+		}
+	}
+
+	if err := store.KeyStore.Delete(account, request.CurrentPassword); err != nil {
+		jsonAPIError(c, http.StatusUnauthorized, err)
+		return
+	}
+	store.TxManager.Register(store.KeyStore.Accounts())
+
+	address, err := models.NewEIP55Address(account.Address.Hex())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+	} else if err := store.DeleteKey(address); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+	} else {
+		jsonAPIResponse(c, presenters.NewAccount{Account: &account}, "account")
+	}
+}
+
+func (kc *KeysController) findAccount(address string) (accounts.Account, error) {
+	if !common.IsHexAddress(address) {
+		return accounts.Account{}, fmt.Errorf("invalid address %s", address)
+	}
+	for _, account := range kc.App.GetStore().KeyStore.GetAccounts() {
+		if account.Address == common.HexToAddress(address) {
+			return account, nil
+		}
+	}
+	return accounts.Account{}, fmt.Errorf("key %s not found", address)
+}
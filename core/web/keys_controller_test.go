@@ -3,15 +3,189 @@ package web_test
 import (
 	"bytes"
 	"encoding/json"
+	"net/http"
 	"testing"
 
 	"chainlink/core/eth"
 	"chainlink/core/internal/cltest"
 	"chainlink/core/store/models"
+	"chainlink/core/store/presenters"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
 )
 
+func TestKeysController_Index(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKey(t)
+	defer cleanup()
+	ethMock, err := app.MockStartAndConnect()
+	require.NoError(t, err)
+
+	app.AddUnlockedKey()
+	client := app.NewHTTPClient()
+
+	ethMock.Context("first key", func(ethMock *cltest.EthMock) {
+		ethMock.Register("eth_getBalance", "0x0100")
+		ethMock.Register("eth_call", "0x0100")
+	})
+	ethMock.Context("second key", func(ethMock *cltest.EthMock) {
+		ethMock.Register("eth_getBalance", "0x01")
+		ethMock.Register("eth_call", "0x01")
+	})
+
+	resp, cleanup := client.Get("/v2/keys")
+	defer cleanup()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	expectedAccounts := app.Store.KeyStore.Accounts()
+	keys := []presenters.Key{}
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &keys))
+	require.Len(t, keys, 2)
+
+	first := keys[0]
+	assert.Equal(t, expectedAccounts[0].Address.Hex(), first.Address)
+	assert.Equal(t, "0.000000000000000256", first.EthBalance.String())
+	assert.Equal(t, "0.000000000000000256", first.LinkBalance.String())
+	require.NotNil(t, first.NextNonce)
+	assert.Equal(t, app.Store.TxManager.GetAvailableAccount(expectedAccounts[0].Address).Nonce(), *first.NextNonce)
+
+	second := keys[1]
+	assert.Equal(t, expectedAccounts[1].Address.Hex(), second.Address)
+	assert.Equal(t, "0.000000000000000001", second.EthBalance.String())
+	assert.Nil(t, second.NextNonce, "key added to the keystore but not registered")
+}
+
+func TestKeysController_Export(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKey(t)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	client := app.NewHTTPClient()
+	address := cltest.GetAccountAddress(t, app.Store)
+
+	resp, cleanup := exportKey(t, client, address.Hex(), cltest.Password)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	exported := gjson.ParseBytes(cltest.ParseResponseBody(t, resp))
+	assert.True(t, exported.Get("crypto").Exists(), "should be an encrypted keystore")
+	assert.Equal(t, address, common.HexToAddress(exported.Get("address").String()))
+
+	resp, cleanup = exportKey(t, client, cltest.NewAddress().Hex(), cltest.Password)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
+
+func TestKeysController_Export_WrongPassword(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKey(t)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	client := app.NewHTTPClient()
+	address := cltest.GetAccountAddress(t, app.Store)
+
+	resp, cleanup := exportKey(t, client, address.Hex(), "wrong password")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusUnauthorized)
+	assert.False(t, gjson.ParseBytes(cltest.ParseResponseBody(t, resp)).Get("crypto").Exists(), "should not export the key")
+}
+
+func exportKey(t *testing.T, client cltest.HTTPClientCleaner, address, password string) (*http.Response, func()) {
+	body, err := json.Marshal(models.ExportKeyRequest{CurrentPassword: password})
+	require.NoError(t, err)
+	return client.Post("/v2/keys/"+address+"/export", bytes.NewBuffer(body))
+}
+
+func TestKeysController_Delete(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKey(t)
+	defer cleanup()
+	ethMock, err := app.MockStartAndConnect()
+	require.NoError(t, err)
+
+	client := app.NewHTTPClient()
+	first := cltest.GetAccountAddress(t, app.Store)
+
+	deleteKey := func(address, password string) *http.Response {
+		body, err := json.Marshal(models.DeleteKeyRequest{CurrentPassword: password})
+		require.NoError(t, err)
+		resp, err := client.HTTPClient.Delete("/v2/keys/"+address, bytes.NewBuffer(body))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		return resp
+	}
+
+	cltest.AssertServerResponse(t, deleteKey(first.Hex(), cltest.Password), http.StatusConflict)
+
+	ethMock.Register("eth_getTransactionCount", "0x100")
+	body, err := json.Marshal(models.CreateKeyRequest{CurrentPassword: cltest.Password})
+	require.NoError(t, err)
+	resp, cleanup := client.Post("/v2/keys", bytes.NewBuffer(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusCreated)
+	ethMock.EventuallyAllCalled(t)
+
+	accounts := app.Store.KeyStore.Accounts()
+	require.Len(t, accounts, 2)
+	second := accounts[1].Address
+	if second == first {
+		second = accounts[0].Address
+	}
+	require.NotNil(t, app.Store.TxManager.GetAvailableAccount(second), "created key should be active")
+
+	cltest.AssertServerResponse(t, deleteKey(cltest.NewAddress().Hex(), cltest.Password), http.StatusNotFound)
+	cltest.AssertServerResponse(t, deleteKey(second.Hex(), "wrong password"), http.StatusUnauthorized)
+
+	tx := cltest.CreateTx(t, app.Store, second, 1)
+	cltest.AssertServerResponse(t, deleteKey(second.Hex(), cltest.Password), http.StatusConflict)
+	tx.Confirmed = true
+	require.NoError(t, app.Store.SaveTx(tx))
+
+	cltest.AssertServerResponse(t, deleteKey(second.Hex(), cltest.Password), http.StatusOK)
+	assert.Len(t, app.Store.KeyStore.Accounts(), 1)
+	assert.Nil(t, app.Store.TxManager.GetAvailableAccount(second), "deleted key should no longer send transactions")
+	assert.NotNil(t, app.Store.TxManager.GetAvailableAccount(first))
+
+	keys, err := app.Store.Keys()
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	assert.Equal(t, first, keys[0].Address.Address())
+}
+
+func TestKeysController_NotDev(t *testing.T) {
+	t.Parallel()
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+	config.Set("CHAINLINK_DEV", false)
+	app, cleanup := cltest.NewApplicationWithConfigAndKey(t, config)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	client := app.NewHTTPClient()
+	address := cltest.GetAccountAddress(t, app.Store)
+
+	resp, cleanup := exportKey(t, client, address.Hex(), cltest.Password)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	body, err := json.Marshal(models.CreateKeyRequest{CurrentPassword: cltest.Password})
+	require.NoError(t, err)
+	resp, cleanup = client.Post("/v2/keys", bytes.NewBuffer(body))
+	defer cleanup()
+	assert.NotEqual(t, http.StatusCreated, resp.StatusCode, "keys are only created in dev mode")
+	assert.Len(t, app.Store.KeyStore.Accounts(), 1)
+}
+
 func TestKeysController_CreateSuccess(t *testing.T) {
 	t.Parallel()
 
@@ -37,10 +211,12 @@ func TestKeysController_CreateSuccess(t *testing.T) {
 	body, err := json.Marshal(&request)
 	assert.NoError(t, err)
 
+	ethMock.Register("eth_getTransactionCount", "0x100")
 	resp, cleanup := client.Post("/v2/keys", bytes.NewBuffer(body))
 	defer cleanup()
 
 	cltest.AssertServerResponse(t, resp, 201)
+	assert.Len(t, app.Store.KeyStore.Accounts(), 2)
 
 	ethMock.AllCalled()
 }
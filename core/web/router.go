@@ -216,6 +216,9 @@ func v2Routes(app services.Application, r *gin.RouterGroup) {
 		authv2.GET("/specs/:SpecID", j.Show)
 		authv2.DELETE("/specs/:SpecID", j.Destroy)
 
+		fmc := FluxMonitorController{app}
+		authv2.GET("/specs/:SpecID/flux_monitor", fmc.Show)
+
 		authv2.GET("/runs", paginatedRequest(jr.Index))
 		authv2.GET("/runs/:RunID", jr.Show)
 		authv2.PUT("/runs/:RunID/cancellation", jr.Cancel)
@@ -235,10 +238,13 @@ func v2Routes(app services.Application, r *gin.RouterGroup) {
 		ts := TransfersController{app}
 		authv2.POST("/transfers", ts.Create)
 
+		kc := KeysController{app}
+		authv2.GET("/keys", kc.Index)
 		if app.GetStore().Config.Dev() {
-			kc := KeysController{app}
 			authv2.POST("/keys", kc.Create)
 		}
+		authv2.POST("/keys/:address/export", kc.Export)
+		authv2.DELETE("/keys/:address", kc.Delete)
 
 		cc := ConfigController{app}
 		authv2.GET("/config", cc.Show)
@@ -250,6 +256,7 @@ func v2Routes(app services.Application, r *gin.RouterGroup) {
 		txs := TransactionsController{app}
 		authv2.GET("/transactions", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/:TxHash", txs.Show)
+		authv2.POST("/transactions/:TxHash/cancel", txs.Cancel)
 
 		bdc := BulkDeletesController{app}
 		authv2.DELETE("/bulk_delete_runs", bdc.Delete)
@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"chainlink/core/services"
+	"chainlink/core/store"
 	"chainlink/core/store/orm"
 	"chainlink/core/store/presenters"
 
@@ -41,3 +42,25 @@ func (tc *TransactionsController) Show(c *gin.Context) {
 		jsonAPIResponse(c, presenters.NewTxFromAttempt(*txAttempt), "transaction")
 	}
 }
+
+// Cancel replaces an unconfirmed transaction with a zero value transfer from
+// its sender to itself, freeing its nonce for later transactions, and cancels
+// the run that sent it.
+// Example:
+//  "<application>/transactions/:TxHash/cancel"
+func (tc *TransactionsController) Cancel(c *gin.Context) {
+	hash := common.HexToHash(c.Param("TxHash"))
+	if tx, err := tc.App.GetStore().TxManager.CancelTx(hash); errors.Cause(err) == orm.ErrorNotFound {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Transaction not found"))
+	} else if err == store.ErrTxConfirmed {
+		jsonAPIError(c, http.StatusConflict, err)
+	} else if errors.Cause(err) == store.ErrPendingConnection {
+		jsonAPIError(c, http.StatusServiceUnavailable, err)
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+	} else if err := tc.App.CancelRunForCancelledTx(tx); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, errors.Wrap(err, "cancelling run of transaction"))
+	} else {
+		jsonAPIResponse(c, presenters.NewTx(tx), "transaction")
+	}
+}
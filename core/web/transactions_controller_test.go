@@ -148,3 +148,73 @@ func TestTransactionsController_Show_NotFound(t *testing.T) {
 	defer cleanup()
 	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
 }
+
+func TestTransactionsController_Cancel_Success(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKey(t)
+	defer cleanup()
+	ethMock, err := app.MockStartAndConnect()
+	require.NoError(t, err)
+
+	store := app.GetStore()
+	client := app.NewHTTPClient()
+	from := cltest.GetAccountAddress(t, store)
+	tx := cltest.CreateTx(t, store, from, 1)
+
+	cancelHash := cltest.NewHash()
+	ethMock.Register("eth_sendRawTransaction", cancelHash)
+
+	resp, cleanup := client.Post("/v2/transactions/"+tx.Hash.String()+"/cancel", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	ethMock.EventuallyAllCalled(t)
+
+	ptx := presenters.Tx{}
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &ptx))
+	assert.Equal(t, &from, ptx.To)
+	assert.Equal(t, "0", ptx.Value)
+
+	cancelled, err := store.FindTx(tx.ID)
+	require.NoError(t, err)
+	require.Len(t, cancelled.Attempts, 2)
+	assert.Equal(t, tx.Nonce, cancelled.Nonce)
+	assert.Equal(t, from, cancelled.To)
+	assert.Empty(t, cancelled.Data)
+	assert.Equal(t, uint64(21000), cancelled.GasLimit)
+	assert.True(t, cancelled.Attempts[1].GasPrice.ToInt().Cmp(cancelled.Attempts[0].GasPrice.ToInt()) > 0)
+}
+
+func TestTransactionsController_Cancel_Errors(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKey(t)
+	defer cleanup()
+	_, err := app.MockStartAndConnect()
+	require.NoError(t, err)
+
+	store := app.GetStore()
+	client := app.NewHTTPClient()
+	from := cltest.GetAccountAddress(t, store)
+	unconfirmed := cltest.CreateTx(t, store, from, 1)
+	confirmed := cltest.CreateTxWithNonce(t, store, from, 2, 1)
+	require.NoError(t, store.MarkTxSafe(confirmed, confirmed.Attempts[0]))
+
+	tests := []struct {
+		name string
+		hash string
+		want int
+	}{
+		{"not found", unconfirmed.Hash.String() + "1", http.StatusNotFound},
+		{"confirmed", confirmed.Hash.String(), http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			resp, cleanup := client.Post("/v2/transactions/"+test.hash+"/cancel", nil)
+			defer cleanup()
+			cltest.AssertServerResponse(t, resp, test.want)
+		})
+	}
+}